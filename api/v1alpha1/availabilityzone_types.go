@@ -0,0 +1,80 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceInfo contains identifying information about the vSphere resources used to represent a Kubernetes
+// namespace on an individual vSphere Zone.
+type NamespaceInfo struct {
+	// PoolMoId is the managed object ID of the vSphere ResourcePool for a Namespace on an individual vSphere Zone.
+	// +optional
+	PoolMoId string `json:"poolMoId,omitempty"`
+
+	// FolderMoId is the managed object ID of the vSphere Folder for a Namespace. Folders are global and not per-Zone,
+	// but the FolderMoId is stored here alongside the PoolMoId for convenience.
+	// +optional
+	FolderMoId string `json:"folderMoId,omitempty"`
+}
+
+// AvailabilityZoneSpec defines the desired state of AvailabilityZone.
+type AvailabilityZoneSpec struct {
+	// ClusterComputeResourceMoIDs are the managed object IDs of the vSphere ClusterComputeResources represented by
+	// this availability zone.
+	// +optional
+	ClusterComputeResourceMoIDs []string `json:"clusterComputeResourceMoIDs,omitempty"`
+
+	// Namespaces is a map that enables querying information about the vSphere objects that make up a Kubernetes
+	// Namespace based on its name.
+	// +optional
+	Namespaces map[string]NamespaceInfo `json:"namespaces,omitempty"`
+}
+
+// AvailabilityZoneStatus defines the observed state of AvailabilityZone.
+type AvailabilityZoneStatus struct {
+	// Conditions describes the current condition information of the AvailabilityZone.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (zone *AvailabilityZone) GetConditions() Conditions {
+	return zone.Status.Conditions
+}
+
+func (zone *AvailabilityZone) SetConditions(conditions Conditions) {
+	zone.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=zone
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AvailabilityZone is the Schema for the availabilityzones API.
+// An AvailabilityZone describes a failure domain of the backing infrastructure, such as a vSphere cluster, and
+// the Namespaces that may place VirtualMachines in it.  The name of an AvailabilityZone is the value used to
+// request a zone in a VirtualMachine's TopologySpec, and the value reported in a VirtualMachine's status.zone.
+type AvailabilityZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AvailabilityZoneSpec   `json:"spec,omitempty"`
+	Status AvailabilityZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AvailabilityZoneList contains a list of AvailabilityZone.
+type AvailabilityZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AvailabilityZone `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&AvailabilityZone{}, &AvailabilityZoneList{})
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compatibility evaluates whether a VirtualMachineClass is compatible with a VirtualMachineImage, and with the
+// hosts a VirtualMachine may be placed on, so that the incompatibilities that would otherwise fail late in vSphere
+// are reported up front, with reasons suitable for the VirtualMachinePrereqReady condition.
+//
+// The hosts are not described by this API: they are described by the caller, e.g. from the HostSystems of the
+// vSphere clusters available to the namespace of the VirtualMachine.
+package compatibility
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/hardware"
+)
+
+// efiGuestIDs are the guest OS identifiers of the VirtualMachineImage OSInfo Type that require the EFI firmware.
+// Windows 11 requires a UEFI firmware capable of Secure Boot, as listed by the Windows 11 system requirements
+// published by Microsoft.
+var efiGuestIDs = map[string]bool{
+	"windows11_64Guest": true,
+}
+
+// Incompatibility describes why a VirtualMachineClass is not compatible with a VirtualMachineImage or with the hosts.
+type Incompatibility struct {
+	// Reason is the reason of the VirtualMachinePrereqReady condition that documents the incompatibility.
+	Reason string
+
+	// Message is a human readable message describing the incompatibility.
+	Message string
+}
+
+// Evaluate returns the incompatibilities between a VirtualMachineClass and a VirtualMachineImage.  It is equivalent
+// to EvaluateVirtualMachine for a VirtualMachine that does not specify any AdvancedOptions or AdditionalDevices, so
+// the firmware is the one of the ConfigSpec of the class, if any.
+func Evaluate(vmClass *v1alpha1.VirtualMachineClass, image *v1alpha1.VirtualMachineImage) ([]Incompatibility, error) {
+	return EvaluateVirtualMachine(&v1alpha1.VirtualMachine{}, vmClass, image)
+}
+
+// EvaluateVirtualMachine returns the incompatibilities between a VirtualMachine, the VirtualMachineClass and the
+// VirtualMachineImage it uses.  The effective hardware of the VirtualMachine is evaluated, so that the devices of
+// the ConfigSpec of the class and the AdditionalDevices and AdvancedOptions of the VirtualMachine are accounted for.
+// When the image is nil, only the incompatibilities that do not depend on the image are evaluated.  An error is
+// returned when the effective hardware cannot be computed.
+func EvaluateVirtualMachine(
+	vm *v1alpha1.VirtualMachine,
+	vmClass *v1alpha1.VirtualMachineClass,
+	image *v1alpha1.VirtualMachineImage) ([]Incompatibility, error) {
+
+	hw, _, err := hardware.Compute(vm, vmClass, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var incompatibilities []Incompatibility
+	add := func(reason, format string, args ...interface{}) {
+		incompatibilities = append(incompatibilities, Incompatibility{
+			Reason:  reason,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	numPCIDevices := len(hw.Devices.VGPUDevices) + len(hw.Devices.DynamicDirectPathIODevices)
+
+	// PCI passthrough devices, such as GPUs, map large memory regions that are only addressable with EFI.
+	if numPCIDevices > 0 && hw.Firmware == v1alpha1.BIOSFirmware {
+		add(v1alpha1.IncompatibleFirmwareReason,
+			"vGPU and Dynamic DirectPath I/O devices require the %s firmware", v1alpha1.EFIFirmware)
+	}
+
+	if image != nil {
+		hwVersion := image.Spec.HardwareVersion
+		if numPCIDevices > 0 && hwVersion != 0 && hwVersion < hardware.MinHardwareVersionPCIPassthrough {
+			add(v1alpha1.IncompatibleHardwareVersionReason,
+				"VirtualMachineImage %s has hardware version %d, but vGPU and Dynamic DirectPath I/O devices require "+
+					"hardware version %d or later", image.Name, hwVersion, hardware.MinHardwareVersionPCIPassthrough)
+		}
+		if hw.VirtualTPM && hwVersion != 0 && hwVersion < hardware.MinHardwareVersionVTPM {
+			add(v1alpha1.IncompatibleHardwareVersionReason,
+				"VirtualMachineImage %s has hardware version %d, but a vTPM requires hardware version %d or later",
+				image.Name, hwVersion, hardware.MinHardwareVersionVTPM)
+		}
+
+		if guestID := image.Spec.OSInfo.Type; efiGuestIDs[guestID] && hw.Firmware == v1alpha1.BIOSFirmware {
+			add(v1alpha1.IncompatibleFirmwareReason,
+				"guest OS %s of VirtualMachineImage %s requires the %s firmware", guestID, image.Name,
+				v1alpha1.EFIFirmware)
+		}
+	}
+
+	if len(hw.InstanceStorage.Volumes) > 0 && len(hw.Devices.VGPUDevices) > 0 {
+		add(v1alpha1.IncompatibleInstanceStorageReason,
+			"instance storage of VirtualMachineClass %s is not supported with vGPU devices", vmClass.Name)
+	}
+
+	return incompatibilities, nil
+}
+
+// Summarize returns the reason and the message of the VirtualMachinePrereqReady condition that documents the
+// incompatibilities: the reason of the first incompatibility, and the messages of all of them.  It returns empty
+// strings when there is no incompatibility.
+func Summarize(incompatibilities []Incompatibility) (reason, message string) {
+	if len(incompatibilities) == 0 {
+		return "", ""
+	}
+
+	messages := make([]string, 0, len(incompatibilities))
+	for _, i := range incompatibilities {
+		messages = append(messages, i.Message)
+	}
+	return incompatibilities[0].Reason, strings.Join(messages, "; ")
+}
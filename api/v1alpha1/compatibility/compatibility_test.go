@@ -0,0 +1,308 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package compatibility
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/configspec"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		vmClass  *v1alpha1.VirtualMachineClass
+		image    *v1alpha1.VirtualMachineImage
+		expected []string
+	}{
+		{
+			name:    "compatible",
+			vmClass: newClass(withVGPU("grid_v100-4q")),
+			image:   newImage(17, "ubuntu64Guest"),
+		},
+		{
+			name:     "hardware version too old for the devices",
+			vmClass:  newClass(withVGPU("grid_v100-4q")),
+			image:    newImage(15, "ubuntu64Guest"),
+			expected: []string{v1alpha1.IncompatibleHardwareVersionReason},
+		},
+		{
+			name:    "unknown hardware version",
+			vmClass: newClass(withVGPU("grid_v100-4q")),
+			image:   newImage(0, "ubuntu64Guest"),
+		},
+		{
+			name:     "bios firmware of the ConfigSpec with devices",
+			vmClass:  newClass(withVGPU("grid_v100-4q"), withFirmware(v1alpha1.BIOSFirmware)),
+			image:    newImage(17, "ubuntu64Guest"),
+			expected: []string{v1alpha1.IncompatibleFirmwareReason},
+		},
+		{
+			name:     "bios firmware of the ConfigSpec with a guest OS requiring efi",
+			vmClass:  newClass(withFirmware(v1alpha1.BIOSFirmware)),
+			image:    newImage(19, "windows11_64Guest"),
+			expected: []string{v1alpha1.IncompatibleFirmwareReason},
+		},
+		{
+			name:     "instance storage with vGPU devices",
+			vmClass:  newClass(withVGPU("grid_v100-4q"), withInstanceStorage("10Gi")),
+			image:    newImage(17, "ubuntu64Guest"),
+			expected: []string{v1alpha1.IncompatibleInstanceStorageReason},
+		},
+		{
+			name:     "no image",
+			vmClass:  newClass(withVGPU("grid_v100-4q"), withFirmware(v1alpha1.BIOSFirmware)),
+			expected: []string{v1alpha1.IncompatibleFirmwareReason},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			incompatibilities, err := Evaluate(tt.vmClass, tt.image)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertReasons(t, incompatibilities, tt.expected...)
+		})
+	}
+}
+
+func TestEvaluateVirtualMachine(t *testing.T) {
+	vm := &v1alpha1.VirtualMachine{}
+	vm.Spec.AdvancedOptions = &v1alpha1.VirtualMachineAdvancedOptions{
+		Firmware:   v1alpha1.BIOSFirmware,
+		VirtualTPM: boolPtr(true),
+	}
+
+	incompatibilities, err := EvaluateVirtualMachine(vm, newClass(), newImage(13, "windows11_64Guest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertReasons(t, incompatibilities,
+		v1alpha1.IncompatibleHardwareVersionReason, v1alpha1.IncompatibleFirmwareReason)
+
+	// The firmware of the AdvancedOptions overrides the one of the ConfigSpec.
+	vm.Spec.AdvancedOptions = &v1alpha1.VirtualMachineAdvancedOptions{Firmware: v1alpha1.EFIFirmware}
+	vmClass := newClass(withVGPU("grid_v100-4q"), withFirmware(v1alpha1.BIOSFirmware))
+	incompatibilities, err = EvaluateVirtualMachine(vm, vmClass, newImage(17, "windows11_64Guest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertReasons(t, incompatibilities)
+}
+
+func TestEvaluateInvalidConfigSpec(t *testing.T) {
+	vmClass := newClass()
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{XML: "not base64"}
+
+	if _, err := Evaluate(vmClass, nil); err == nil {
+		t.Errorf("expected an error")
+	}
+	if _, err := EvaluateHosts(&v1alpha1.VirtualMachine{}, vmClass, nil, nil); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestEvaluateHosts(t *testing.T) {
+	nvidia := v1alpha1.DynamicDirectPathIODevice{VendorID: 4318, DeviceID: 7864}
+	intel := v1alpha1.DynamicDirectPathIODevice{VendorID: 32902, DeviceID: 5546}
+
+	tests := []struct {
+		name     string
+		vm       *v1alpha1.VirtualMachine
+		image    *v1alpha1.VirtualMachineImage
+		hosts    []Host
+		expected string
+	}{
+		{
+			name:  "compatible host",
+			image: newImage(15, "ubuntu64Guest"),
+			hosts: []Host{
+				{Name: "host-1", MaxHardwareVersion: 15},
+			},
+		},
+		{
+			name:     "no host",
+			hosts:    nil,
+			expected: "no host is available",
+		},
+		{
+			name:  "hardware version of the image",
+			image: newImage(19, "ubuntu64Guest"),
+			hosts: []Host{
+				{Name: "host-1", MaxHardwareVersion: 17},
+			},
+			expected: "host host-1 supports hardware version 17, but version 19 is required",
+		},
+		{
+			name: "hardware version required by the devices",
+			vm:   newVM(&v1alpha1.VirtualDevices{VGPUDevices: []v1alpha1.VGPUDevice{{ProfileName: "grid_v100-4q"}}}),
+			hosts: []Host{
+				{Name: "host-1", MaxHardwareVersion: 15, VGPUProfileNames: []string{"grid_v100-4q"}},
+			},
+			expected: "host host-1 supports hardware version 15, but version 17 is required",
+		},
+		{
+			name: "one of the hosts supports the vGPU profile",
+			vm:   newVM(&v1alpha1.VirtualDevices{VGPUDevices: []v1alpha1.VGPUDevice{{ProfileName: "grid_v100-4q"}}}),
+			hosts: []Host{
+				{Name: "host-1", VGPUProfileNames: []string{"grid_t4-4q"}},
+				{Name: "host-2", VGPUProfileNames: []string{"grid_t4-4q", "grid_v100-4q"}},
+			},
+		},
+		{
+			name: "none of the hosts supports the vGPU profile",
+			vm:   newVM(&v1alpha1.VirtualDevices{VGPUDevices: []v1alpha1.VGPUDevice{{ProfileName: "grid_v100-4q"}}}),
+			hosts: []Host{
+				{Name: "host-1", VGPUProfileNames: []string{"grid_t4-4q"}},
+				{Name: "host-2"},
+			},
+			expected: "none of the 2 hosts is compatible: host host-1 does not support vGPU profile grid_v100-4q, " +
+				"host host-2 does not support vGPU profile grid_v100-4q",
+		},
+		{
+			name: "enough Dynamic DirectPath I/O devices",
+			vm: newVM(&v1alpha1.VirtualDevices{
+				DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{nvidia, nvidia},
+			}),
+			hosts: []Host{
+				{Name: "host-1", DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{nvidia, intel, nvidia}},
+			},
+		},
+		{
+			// Each physical device backs a single device of the VirtualMachine.
+			name: "not enough Dynamic DirectPath I/O devices",
+			vm: newVM(&v1alpha1.VirtualDevices{
+				DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{nvidia, nvidia},
+			}),
+			hosts: []Host{
+				{Name: "host-1", DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{nvidia, intel}},
+			},
+			expected: "host host-1 does not have an available Dynamic DirectPath I/O device with vendor ID 4318 " +
+				"and device ID 7864",
+		},
+		{
+			name: "custom label",
+			vm: newVM(&v1alpha1.VirtualDevices{
+				DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{
+					{VendorID: 4318, DeviceID: 7864, CustomLabel: "gpu-a"},
+				},
+			}),
+			hosts: []Host{
+				{Name: "host-1", DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{
+					{VendorID: 4318, DeviceID: 7864, CustomLabel: "gpu-b"},
+				}},
+			},
+			expected: "host host-1 does not have an available Dynamic DirectPath I/O device",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vm := tt.vm
+			if vm == nil {
+				vm = &v1alpha1.VirtualMachine{}
+			}
+
+			incompatibilities, err := EvaluateHosts(vm, newClass(), tt.image, tt.hosts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.expected == "" {
+				assertReasons(t, incompatibilities)
+				return
+			}
+			assertReasons(t, incompatibilities, v1alpha1.IncompatibleHostsReason)
+			if len(incompatibilities) == 1 && !strings.Contains(incompatibilities[0].Message, tt.expected) {
+				t.Errorf("Message = %q, want it to contain %q", incompatibilities[0].Message, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	if reason, message := Summarize(nil); reason != "" || message != "" {
+		t.Errorf("Summarize(nil) = %q, %q, want empty strings", reason, message)
+	}
+
+	reason, message := Summarize([]Incompatibility{
+		{Reason: v1alpha1.IncompatibleFirmwareReason, Message: "a"},
+		{Reason: v1alpha1.IncompatibleHardwareVersionReason, Message: "b"},
+	})
+	if reason != v1alpha1.IncompatibleFirmwareReason || message != "a; b" {
+		t.Errorf("Summarize() = %q, %q, want %q, %q", reason, message, v1alpha1.IncompatibleFirmwareReason, "a; b")
+	}
+}
+
+type classOption func(*v1alpha1.VirtualMachineClass)
+
+func withVGPU(profileName string) classOption {
+	return func(vmClass *v1alpha1.VirtualMachineClass) {
+		vmClass.Spec.Hardware.Devices.VGPUDevices = append(vmClass.Spec.Hardware.Devices.VGPUDevices,
+			v1alpha1.VGPUDevice{ProfileName: profileName})
+	}
+}
+
+func withFirmware(firmware v1alpha1.VirtualMachineFirmware) classOption {
+	return func(vmClass *v1alpha1.VirtualMachineClass) {
+		vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{
+			XML: configspec.Encode(&configspec.ConfigSpec{Firmware: string(firmware)}),
+		}
+	}
+}
+
+func withInstanceStorage(size string) classOption {
+	return func(vmClass *v1alpha1.VirtualMachineClass) {
+		vmClass.Spec.Hardware.InstanceStorage.Volumes = append(vmClass.Spec.Hardware.InstanceStorage.Volumes,
+			v1alpha1.InstanceStorageVolume{Size: resource.MustParse(size)})
+	}
+}
+
+func newClass(opts ...classOption) *v1alpha1.VirtualMachineClass {
+	vmClass := &v1alpha1.VirtualMachineClass{}
+	vmClass.Name = "my-class"
+	vmClass.Spec.Hardware.Cpus = 2
+	vmClass.Spec.Hardware.Memory = resource.MustParse("4Gi")
+	for _, opt := range opts {
+		opt(vmClass)
+	}
+	return vmClass
+}
+
+func newImage(hwVersion int32, guestID string) *v1alpha1.VirtualMachineImage {
+	image := &v1alpha1.VirtualMachineImage{}
+	image.Name = "my-image"
+	image.Spec.HardwareVersion = hwVersion
+	image.Spec.OSInfo.Type = guestID
+	return image
+}
+
+func newVM(devices *v1alpha1.VirtualDevices) *v1alpha1.VirtualMachine {
+	vm := &v1alpha1.VirtualMachine{}
+	vm.Spec.AdditionalDevices = devices
+	return vm
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// assertReasons asserts that the incompatibilities have the given reasons, in order.
+func assertReasons(t *testing.T, incompatibilities []Incompatibility, reasons ...string) {
+	t.Helper()
+
+	var got []string
+	for _, i := range incompatibilities {
+		got = append(got, i.Reason)
+	}
+	if !reflect.DeepEqual(got, reasons) {
+		t.Errorf("reasons = %v, want %v (%+v)", got, reasons, incompatibilities)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package compatibility
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/hardware"
+)
+
+// Host describes an ESXi host a VirtualMachine may be placed on.
+type Host struct {
+	// Name is the name of the host.
+	Name string
+
+	// MaxHardwareVersion is the most recent virtual hardware version supported by the host.  Zero means unknown, in
+	// which case every hardware version is assumed to be supported.
+	MaxHardwareVersion int32
+
+	// VGPUProfileNames are the vGPU profiles supported by the physical GPUs of the host.
+	VGPUProfileNames []string
+
+	// DynamicDirectPathIODevices are the physical PCI devices of the host available for Dynamic DirectPath I/O.  Each
+	// of them may back a single device of a VirtualMachine.
+	DynamicDirectPathIODevices []v1alpha1.DynamicDirectPathIODevice
+}
+
+// EvaluateHosts returns the incompatibilities between a VirtualMachine and the hosts it may be placed on.  A host is
+// compatible when it supports the hardware version required by the VirtualMachineImage and the devices, every vGPU
+// profile and enough matching Dynamic DirectPath I/O devices.  A single incompatibility, listing why each host is
+// not compatible, is returned when none of the hosts is.  The image may be nil.  An error is returned when the
+// effective hardware cannot be computed.
+func EvaluateHosts(
+	vm *v1alpha1.VirtualMachine,
+	vmClass *v1alpha1.VirtualMachineClass,
+	image *v1alpha1.VirtualMachineImage,
+	hosts []Host) ([]Incompatibility, error) {
+
+	hw, _, err := hardware.Compute(vm, vmClass, image)
+	if err != nil {
+		return nil, err
+	}
+
+	hwVersion := requiredHardwareVersion(hw)
+
+	if len(hosts) == 0 {
+		return []Incompatibility{{
+			Reason:  v1alpha1.IncompatibleHostsReason,
+			Message: "no host is available",
+		}}, nil
+	}
+
+	messages := make([]string, 0, len(hosts))
+	for i := range hosts {
+		message := hostIncompatibility(&hosts[i], hwVersion, &hw.Devices)
+		if message == "" {
+			return nil, nil
+		}
+		messages = append(messages, fmt.Sprintf("host %s %s", hosts[i].Name, message))
+	}
+
+	return []Incompatibility{{
+		Reason:  v1alpha1.IncompatibleHostsReason,
+		Message: fmt.Sprintf("none of the %d hosts is compatible: %s", len(hosts), strings.Join(messages, ", ")),
+	}}, nil
+}
+
+// requiredHardwareVersion returns the hardware version the VirtualMachine requires from its host: the one of the
+// image, raised as required by the devices.  Zero means that any hardware version is supported.
+func requiredHardwareVersion(hw *hardware.Effective) int32 {
+	hwVersion := hw.HardwareVersion
+	if len(hw.Devices.VGPUDevices)+len(hw.Devices.DynamicDirectPathIODevices) > 0 &&
+		hwVersion < hardware.MinHardwareVersionPCIPassthrough {
+		hwVersion = hardware.MinHardwareVersionPCIPassthrough
+	} else if hw.VirtualTPM && hwVersion < hardware.MinHardwareVersionVTPM {
+		hwVersion = hardware.MinHardwareVersionVTPM
+	}
+	return hwVersion
+}
+
+// hostIncompatibility returns why the host does not support the hardware version and the devices, or an empty string
+// when it does.
+func hostIncompatibility(host *Host, hwVersion int32, devices *v1alpha1.VirtualDevices) string {
+	if host.MaxHardwareVersion != 0 && hwVersion > host.MaxHardwareVersion {
+		return fmt.Sprintf("supports hardware version %d, but version %d is required", host.MaxHardwareVersion,
+			hwVersion)
+	}
+
+	profileNames := make(map[string]bool, len(host.VGPUProfileNames))
+	for _, p := range host.VGPUProfileNames {
+		profileNames[p] = true
+	}
+	for _, d := range devices.VGPUDevices {
+		if !profileNames[d.ProfileName] {
+			return fmt.Sprintf("does not support vGPU profile %s", d.ProfileName)
+		}
+	}
+
+	// Each physical device backs a single device of the VirtualMachine.
+	used := make([]bool, len(host.DynamicDirectPathIODevices))
+	for _, d := range devices.DynamicDirectPathIODevices {
+		found := false
+		for i, hd := range host.DynamicDirectPathIODevices {
+			if !used[i] && hd.VendorID == d.VendorID && hd.DeviceID == d.DeviceID &&
+				(d.CustomLabel == "" || hd.CustomLabel == d.CustomLabel) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("does not have an available Dynamic DirectPath I/O device with vendor ID %d and "+
+				"device ID %d", d.VendorID, d.DeviceID)
+		}
+	}
+
+	return ""
+}
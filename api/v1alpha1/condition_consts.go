@@ -35,6 +35,39 @@ const (
 	// VirtualMachineImageNotFoundReason (Severity=Error) documents that the VirtualMachineImage specified in the VirtualMachineSpec
 	// is not available.
 	VirtualMachineImageNotFoundReason = "VirtualMachineImageNotFound"
+
+	// AvailabilityZoneNotFoundReason (Severity=Error) documents that an AvailabilityZone specified in the
+	// VirtualMachineSpec topology is not available, or is not available to the VirtualMachine's namespace.
+	AvailabilityZoneNotFoundReason = "AvailabilityZoneNotFound"
+
+	// VirtualMachineDevicesNotAllowedReason (Severity=Error) documents that the AdditionalDevices specified in the
+	// VirtualMachineSpec are not allowed by the devices policy of the VirtualMachineClass.
+	VirtualMachineDevicesNotAllowedReason = "VirtualMachineDevicesNotAllowed"
+
+	// VirtualMachineQuotaExceededReason (Severity=Error) documents that the VirtualMachine, with the
+	// VirtualMachineClass specified in the VirtualMachineSpec, exceeds a hard limit of a VirtualMachineQuota of its
+	// namespace.
+	VirtualMachineQuotaExceededReason = "VirtualMachineQuotaExceeded"
+
+	// IncompatibleHardwareVersionReason (Severity=Error) documents that the HardwareVersion of the
+	// VirtualMachineImage is lower than the one required by the devices of the VirtualMachineClass.
+	IncompatibleHardwareVersionReason = "IncompatibleHardwareVersion"
+
+	// IncompatibleFirmwareReason (Severity=Error) documents that the firmware of the VirtualMachine is not supported
+	// by the devices of the VirtualMachineClass, or by the guest OS of the VirtualMachineImage.
+	IncompatibleFirmwareReason = "IncompatibleFirmware"
+
+	// IncompatibleInstanceStorageReason (Severity=Error) documents that the instance storage of the
+	// VirtualMachineClass cannot be combined with the devices of the VirtualMachine.
+	IncompatibleInstanceStorageReason = "IncompatibleInstanceStorage"
+
+	// IncompatibleHostsReason (Severity=Error) documents that none of the hosts the VirtualMachine may be placed on
+	// supports its hardware version, its vGPU profiles or its Dynamic DirectPath I/O devices.
+	IncompatibleHostsReason = "IncompatibleHosts"
+
+	// VirtualMachineClassNotSelectedReason (Severity=Error) documents that none of the VirtualMachineClasses
+	// available to the namespace satisfy the Resources specified in the VirtualMachineSpec.
+	VirtualMachineClassNotSelectedReason = "VirtualMachineClassNotSelected"
 )
 
 const (
@@ -57,6 +90,33 @@ const (
 	GuestCustomizationFailedReason = "GuestCustomizationFailed"
 )
 
+// Conditions and condition Reasons for the encryption of the VirtualMachine.
+const (
+	// VirtualMachineEncryptionSyncedCondition exposes whether the encryption of the VirtualMachine, and its vTPM,
+	// match the VirtualMachineSpec.
+	VirtualMachineEncryptionSyncedCondition ConditionType = "VirtualMachineEncryptionSynced"
+
+	// EncryptionClassNotFoundReason (Severity=Error) documents that the EncryptionClass specified in the
+	// VirtualMachineSpec is not available.
+	EncryptionClassNotFoundReason = "EncryptionClassNotFound"
+
+	// KeyProviderNotFoundReason (Severity=Error) documents that the key provider specified in the VirtualMachineSpec,
+	// or the default key provider, is not available.
+	KeyProviderNotFoundReason = "KeyProviderNotFound"
+
+	// EncryptionPendingReason (Severity=Info) documents that the VirtualMachine is being encrypted, re-keyed or
+	// decrypted.
+	EncryptionPendingReason = "EncryptionPending"
+
+	// EncryptionReconfigureErrorReason (Severity=Error) documents that the VirtualMachine could not be encrypted,
+	// re-keyed or decrypted.
+	EncryptionReconfigureErrorReason = "EncryptionReconfigureError"
+
+	// EncryptionRequiresPowerOffReason (Severity=Warning) documents that the requested change to the encryption of
+	// the VirtualMachine can only be applied when the VirtualMachine is powered off.
+	EncryptionRequiresPowerOffReason = "EncryptionRequiresPowerOff"
+)
+
 const (
 	// VirtualMachineToolsCondition exposes the status of VMware Tools running in the guest OS, when available
 	VirtualMachineToolsCondition ConditionType = "VirtualMachineTools"
@@ -68,6 +128,40 @@ const (
 	VirtualMachineToolsRunningReason = "VirtualMachineToolsRunning"
 )
 
+// Reasons for the State of the in-place resize of a VirtualMachine, reported in its VirtualMachineResizeStatus.
+const (
+	// ResizeClassShrinksReason documents that the new VirtualMachineClass has fewer CPUs or less memory than the
+	// current one, which cannot be applied to a powered on VirtualMachine.
+	ResizeClassShrinksReason = "ClassShrinks"
+
+	// ResizeHotAddDisabledReason documents that the new VirtualMachineClass has more CPUs or memory than the
+	// current one, but hot-add is not enabled on the powered on VirtualMachine.
+	ResizeHotAddDisabledReason = "HotAddDisabled"
+
+	// ResizeHardwareChangedReason documents that the new VirtualMachineClass changes hardware other than CPUs and
+	// memory, such as devices or instance storage, which cannot be applied to a powered on VirtualMachine.
+	ResizeHardwareChangedReason = "HardwareChanged"
+
+	// ResizeReconfigureErrorReason documents that the reconfiguration of the VirtualMachine failed.
+	ResizeReconfigureErrorReason = "ReconfigureError"
+)
+
+// Conditions and condition Reasons for objects whose reconciliation can be paused.
+const (
+	// PausedCondition documents that VM Operator is not reconciling the object with the vSphere infrastructure.
+	// The condition is True while reconciliation is paused.
+	PausedCondition ConditionType = "Paused"
+
+	// PausedByAnnotationReason documents that reconciliation is paused by the PauseAnnotation.
+	PausedByAnnotationReason = "PausedByAnnotation"
+
+	// PausedBySpecReason documents that reconciliation is paused by the object's spec.pause field.
+	PausedBySpecReason = "PausedBySpec"
+
+	// PauseExpiredReason documents that reconciliation was resumed because the time in spec.pause.until passed.
+	PauseExpiredReason = "PauseExpired"
+)
+
 // Common Condition.Reason used by VM Operator API objects.
 const (
 	// DeletingReason (Severity=Info) documents a condition not in Status=True because the underlying object it is currently being deleted.
@@ -106,3 +200,211 @@ const (
 	// is not prepared for VMService consumption.
 	VirtualMachineImageV1Alpha1NotCompatibleReason = "VirtualMachineImageV1Alpha1NotCompatible"
 )
+
+// Conditions and condition Reasons for the VirtualMachineReplicaSet object.
+const (
+	// VirtualMachineReplicaSetReplicaFailureCondition documents that one of the VirtualMachines of a
+	// VirtualMachineReplicaSet failed to be created or deleted.
+	VirtualMachineReplicaSetReplicaFailureCondition ConditionType = "ReplicaFailure"
+
+	// VirtualMachineCreateFailedReason (Severity=Error) documents that a VirtualMachine of a replicated group could
+	// not be created.
+	VirtualMachineCreateFailedReason = "VirtualMachineCreateFailed"
+
+	// VirtualMachineDeleteFailedReason (Severity=Warning) documents that a VirtualMachine of a replicated group could
+	// not be deleted. This is a warning because the controller will retry the deletion.
+	VirtualMachineDeleteFailedReason = "VirtualMachineDeleteFailed"
+)
+
+// Conditions and condition Reasons for the VirtualMachineDeployment object.
+const (
+	// VirtualMachineDeploymentAvailableCondition documents that the VirtualMachineDeployment has at least the
+	// minimum number of available VirtualMachines required by its strategy.
+	VirtualMachineDeploymentAvailableCondition ConditionType = "Available"
+
+	// VirtualMachineDeploymentProgressingCondition documents that the VirtualMachineDeployment is making progress
+	// on a rollout, or that it has successfully completed one.
+	VirtualMachineDeploymentProgressingCondition ConditionType = "Progressing"
+
+	// MinimumReplicasUnavailableReason (Severity=Warning) documents that the VirtualMachineDeployment does not have
+	// the minimum number of available VirtualMachines.
+	MinimumReplicasUnavailableReason = "MinimumReplicasUnavailable"
+
+	// NewReplicaSetCreatedReason (Severity=Info) documents that a new VirtualMachineReplicaSet has been created
+	// for a rollout.
+	NewReplicaSetCreatedReason = "NewReplicaSetCreated"
+
+	// ReplicaSetUpdatedReason (Severity=Info) documents that a VirtualMachineReplicaSet is being scaled as part
+	// of a rollout.
+	ReplicaSetUpdatedReason = "ReplicaSetUpdated"
+
+	// NewReplicaSetAvailableReason documents that the rollout has completed and all VirtualMachines of the
+	// newest VirtualMachineReplicaSet are available.
+	NewReplicaSetAvailableReason = "NewReplicaSetAvailable"
+
+	// ProgressDeadlineExceededReason (Severity=Error) documents that the rollout did not make progress within
+	// ProgressDeadlineSeconds.
+	ProgressDeadlineExceededReason = "ProgressDeadlineExceeded"
+
+	// DeploymentPausedReason (Severity=Info) documents that the VirtualMachineDeployment is paused.
+	DeploymentPausedReason = "DeploymentPaused"
+
+	// DeploymentResumedReason (Severity=Info) documents that the VirtualMachineDeployment has been resumed.
+	DeploymentResumedReason = "DeploymentResumed"
+
+	// RollbackRevisionNotFoundReason (Severity=Error) documents that the revision requested in RollbackTo is not
+	// retained in the revision history.
+	RollbackRevisionNotFoundReason = "RollbackRevisionNotFound"
+
+	// RollbackDoneReason (Severity=Info) documents that the VirtualMachineDeployment was rolled back to the
+	// revision requested in RollbackTo.
+	RollbackDoneReason = "RollbackDone"
+)
+
+// Conditions and condition Reasons for the VirtualMachinePublishRequest object.
+const (
+	// VirtualMachinePublishRequestSourceValidCondition documents that the source VirtualMachine of a
+	// VirtualMachinePublishRequest exists and is in a state that allows it to be published.
+	VirtualMachinePublishRequestSourceValidCondition ConditionType = "SourceValid"
+
+	// VirtualMachinePublishRequestTargetValidCondition documents that the target ContentLibrary of a
+	// VirtualMachinePublishRequest exists and is writable, and that the target item name is available or may be
+	// overwritten.
+	VirtualMachinePublishRequestTargetValidCondition ConditionType = "TargetValid"
+
+	// VirtualMachinePublishRequestUploadedCondition documents that the OVF of the source VirtualMachine has been
+	// uploaded to the target content library item.
+	VirtualMachinePublishRequestUploadedCondition ConditionType = "Uploaded"
+
+	// VirtualMachinePublishRequestImageAvailableCondition documents that a VirtualMachineImage corresponding to the
+	// published content library item is available.
+	VirtualMachinePublishRequestImageAvailableCondition ConditionType = "ImageAvailable"
+
+	// SourceVirtualMachineNotExistReason (Severity=Error) documents that the source VirtualMachine does not exist.
+	SourceVirtualMachineNotExistReason = "SourceVirtualMachineNotExist"
+
+	// SourceVirtualMachineNotCreatedReason (Severity=Info) documents that the source VirtualMachine has not been
+	// created by the backing infrastructure provider yet.
+	SourceVirtualMachineNotCreatedReason = "SourceVirtualMachineNotCreated"
+
+	// TargetContentLibraryNotExistReason (Severity=Error) documents that the target ContentLibrary does not exist.
+	TargetContentLibraryNotExistReason = "TargetContentLibraryNotExist"
+
+	// TargetContentLibraryNotWritableReason (Severity=Error) documents that the target ContentLibrary is not
+	// writable, e.g. because it is a subscribed library.
+	TargetContentLibraryNotWritableReason = "TargetContentLibraryNotWritable"
+
+	// TargetItemAlreadyExistsReason (Severity=Error) documents that an item with the target name already exists in
+	// the target ContentLibrary and Overwrite is false.
+	TargetItemAlreadyExistsReason = "TargetItemAlreadyExists"
+
+	// UploadTaskNotStartedReason (Severity=Info) documents that the upload task has not been started yet.
+	UploadTaskNotStartedReason = "NotStarted"
+
+	// UploadTaskQueuedReason (Severity=Info) documents that the upload task has been queued.
+	UploadTaskQueuedReason = "Queued"
+
+	// UploadingReason (Severity=Info) documents that the upload task is running.
+	UploadingReason = "Uploading"
+
+	// UploadFailureReason (Severity=Error) documents that the upload task failed.
+	UploadFailureReason = "UploadFailure"
+
+	// ImageUnavailableReason (Severity=Info) documents that the VirtualMachineImage for the published item is not
+	// available yet.
+	ImageUnavailableReason = "ImageUnavailable"
+)
+
+// Conditions and condition Reasons for the VirtualMachineImport object.
+const (
+	// VirtualMachineImportSourceFoundCondition documents that the source vSphere VM of a VirtualMachineImport has
+	// been located.
+	VirtualMachineImportSourceFoundCondition ConditionType = "SourceFound"
+
+	// VirtualMachineImportClassMatchedCondition documents that the hardware of the source vSphere VM matches a
+	// VirtualMachineClass available to the namespace.
+	VirtualMachineImportClassMatchedCondition ConditionType = "ClassMatched"
+
+	// VirtualMachineImportImportedCondition documents that the source vSphere VM is managed by the produced
+	// VirtualMachine.
+	VirtualMachineImportImportedCondition ConditionType = "Imported"
+
+	// SourceVMNotFoundReason (Severity=Error) documents that no vSphere VM with the specified UUID exists.
+	SourceVMNotFoundReason = "SourceVMNotFound"
+
+	// SourceVMAlreadyManagedReason (Severity=Error) documents that the source vSphere VM is already managed by a
+	// VirtualMachine.
+	SourceVMAlreadyManagedReason = "SourceVMAlreadyManaged"
+
+	// NoMatchingVirtualMachineClassReason (Severity=Error) documents that none of the VirtualMachineClasses
+	// available to the namespace match the hardware of the source vSphere VM.
+	NoMatchingVirtualMachineClassReason = "NoMatchingVirtualMachineClass"
+
+	// VirtualMachineClassMismatchReason (Severity=Warning) documents that the hardware of the source vSphere VM,
+	// e.g. its CPU or memory, differs from the VirtualMachineClass specified in the VirtualMachineImportSpec.
+	VirtualMachineClassMismatchReason = "VirtualMachineClassMismatch"
+
+	// UnsupportedSettingsReason (Severity=Warning) documents that the source vSphere VM has settings that cannot be
+	// represented by a VirtualMachineSpec.  The settings are listed in the status.
+	UnsupportedSettingsReason = "UnsupportedSettings"
+)
+
+// Conditions and condition Reasons for the VirtualMachineMigration object.
+const (
+	// VirtualMachineMigrationSourceMigratableCondition documents that the VirtualMachine of a
+	// VirtualMachineMigration is in a state that allows it to be migrated.
+	VirtualMachineMigrationSourceMigratableCondition ConditionType = "SourceMigratable"
+
+	// VirtualMachineMigrationCompletedCondition documents that the migration has completed.
+	VirtualMachineMigrationCompletedCondition ConditionType = "Completed"
+
+	// SourceVirtualMachineNotMigratableReason (Severity=Error) documents that the VirtualMachine is not in a state
+	// that allows it to be migrated, e.g. it is being deleted or has instance storage volumes.
+	SourceVirtualMachineNotMigratableReason = "SourceVirtualMachineNotMigratable"
+
+	// MigrationInProgressReason (Severity=Info) documents that the migration is running.
+	MigrationInProgressReason = "MigrationInProgress"
+
+	// MigrationFailedReason (Severity=Error) documents that the migration task failed.
+	MigrationFailedReason = "MigrationFailed"
+)
+
+// Conditions and condition Reasons for the VirtualMachineBackup and VirtualMachineRestore objects.
+const (
+	// VirtualMachineBackupReadyCondition documents that the VirtualMachine of a VirtualMachineBackup, or the
+	// VirtualMachineBackup of a VirtualMachineRestore, is available and in a state that allows the request to proceed.
+	VirtualMachineBackupReadyCondition ConditionType = "BackupReady"
+
+	// VirtualMachineBackupCompletedCondition documents that the backup or the restore has completed.
+	VirtualMachineBackupCompletedCondition ConditionType = "Completed"
+
+	// ChangeBlockTrackingNotEnabledReason (Severity=Error) documents that an incremental backup was requested for a
+	// VirtualMachine that does not have ChangeBlockTracking enabled.
+	ChangeBlockTrackingNotEnabledReason = "ChangeBlockTrackingNotEnabled"
+
+	// BaseBackupNotFoundReason (Severity=Error) documents that the base backup of an incremental backup does not
+	// exist, is not completed, or is of another VirtualMachine.
+	BaseBackupNotFoundReason = "BaseBackupNotFound"
+
+	// QuiesceFailedReason (Severity=Error) documents that the guest file systems could not be quiesced.
+	QuiesceFailedReason = "QuiesceFailed"
+
+	// BackupNotFoundReason (Severity=Error) documents that the VirtualMachineBackup of a VirtualMachineRestore does
+	// not exist or is not completed.
+	BackupNotFoundReason = "BackupNotFound"
+
+	// RestoreTargetExistsReason (Severity=Error) documents that a VirtualMachine or a PersistentVolumeClaim with a
+	// restored name already exists in the target namespace.
+	RestoreTargetExistsReason = "RestoreTargetExists"
+)
+
+// Conditions and condition Reasons for the VirtualMachineClassBinding object.
+const (
+	// VirtualMachineClassBindingClassesFoundCondition documents that the VirtualMachineClasses bound by a
+	// VirtualMachineClassBinding exist.
+	VirtualMachineClassBindingClassesFoundCondition ConditionType = "ClassesFound"
+
+	// NoVirtualMachineClassSelectedReason (Severity=Warning) documents that the ClassSelector of a
+	// VirtualMachineClassBinding does not select any VirtualMachineClass.
+	NoVirtualMachineClassSelectedReason = "NoVirtualMachineClassSelected"
+)
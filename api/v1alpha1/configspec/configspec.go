@@ -0,0 +1,185 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package configspec decodes and encodes the base64-encoded, XML-serialized vim.vm.ConfigSpec carried by a
+// VirtualMachineConfigSpec.  Only the subset of the vim.vm.ConfigSpec used by VirtualMachineClasses is typed: the
+// number of CPUs and their topology, the memory size, the hyperthread sharing, the CPU and memory shares, the
+// latency sensitivity, the firmware, the extra configuration and the addition, removal and edition of network
+// interfaces, disks, vGPU and Dynamic DirectPath I/O devices.  The elements outside of this subset are reported as
+// UnsupportedElements when decoding, and are not encoded.  This subset is also the one described by the Structured
+// form of a VirtualMachineConfigSpec, which can be converted to and from the XML form.
+package configspec
+
+// VirtualMachineConfigSpecType is the vim type name of the root element of a serialized ConfigSpec.
+const VirtualMachineConfigSpecType = "VirtualMachineConfigSpec"
+
+// ConfigSpec is the typed subset of a vim.vm.ConfigSpec.
+type ConfigSpec struct {
+	// NumCPUs is the number of virtual CPUs.  Zero means unset.
+	NumCPUs int32
+
+	// NumCoresPerSocket is the number of cores per virtual CPU socket.  Zero means unset.
+	NumCoresPerSocket int32
+
+	// MemoryMB is the size of the memory, in MB.  Zero means unset.
+	MemoryMB int64
+
+	// HTSharing is the hyperthread sharing of the flags, e.g. "none".  Empty means unset.
+	HTSharing string
+
+	// CPUShares are the shares of the CPU allocation.
+	CPUShares *Shares
+
+	// MemoryShares are the shares of the memory allocation.
+	MemoryShares *Shares
+
+	// LatencySensitivity is the level of the latency sensitivity, e.g. "high".  Empty means unset.
+	LatencySensitivity string
+
+	// Firmware is the firmware interface, e.g. "efi".  Empty means unset.
+	Firmware string
+
+	// DeviceChanges are the changes applied to the virtual devices, in order.
+	DeviceChanges []DeviceChange
+
+	// ExtraConfig are the additional configuration key/value pairs.  They are encoded sorted by key.
+	ExtraConfig []OptionValue
+}
+
+// Shares are the shares of a resource allocation, i.e. a vim.SharesInfo.
+type Shares struct {
+	// Level is the level of the shares, e.g. "custom".
+	Level string
+
+	// Shares is the number of shares, when Level is "custom".
+	Shares int32
+}
+
+// OptionValue is a key/value pair of the extra configuration of a virtual machine.
+type OptionValue struct {
+	Key   string
+	Value string
+}
+
+// DeviceOperation is the operation of a DeviceChange.
+type DeviceOperation string
+
+// See govmomi.vim25.types.VirtualDeviceConfigSpecOperation
+const (
+	DeviceOperationAdd    DeviceOperation = "add"
+	DeviceOperationRemove DeviceOperation = "remove"
+	DeviceOperationEdit   DeviceOperation = "edit"
+)
+
+// DeviceFileOperation is the operation applied to the file backing a device, such as the VMDK of a disk.
+type DeviceFileOperation string
+
+// See govmomi.vim25.types.VirtualDeviceConfigSpecFileOperation
+const (
+	DeviceFileOperationCreate  DeviceFileOperation = "create"
+	DeviceFileOperationDestroy DeviceFileOperation = "destroy"
+	DeviceFileOperationReplace DeviceFileOperation = "replace"
+)
+
+// DeviceChange is a change to a virtual device, i.e. a vim.vm.device.VirtualDeviceSpec.
+type DeviceChange struct {
+	Operation     DeviceOperation
+	FileOperation DeviceFileOperation
+	Device        VirtualDevice
+}
+
+// DeviceKind is the kind of a VirtualDevice.
+type DeviceKind string
+
+const (
+	DeviceKindNIC                 DeviceKind = "NIC"
+	DeviceKindDisk                DeviceKind = "Disk"
+	DeviceKindVGPU                DeviceKind = "VGPU"
+	DeviceKindDynamicDirectPathIO DeviceKind = "DynamicDirectPathIO"
+)
+
+// VirtualDevice is a virtual device.  Exactly one of NIC, Disk, VGPU and DynamicDirectPathIO is set, according to
+// the Kind of the device.
+type VirtualDevice struct {
+	// Kind is the kind of the device.
+	Kind DeviceKind
+
+	// Type is the vim type name of the device, e.g. "VirtualVmxnet3" or "VirtualPCIPassthrough".
+	Type string
+
+	// Key identifies the device within the virtual machine.  Negative keys refer to devices being added.
+	Key int32
+
+	// ControllerKey is the key of the controller the device is attached to.
+	ControllerKey *int32
+
+	// UnitNumber is the unit number of the device on its controller.
+	UnitNumber *int32
+
+	NIC                 *NIC
+	Disk                *Disk
+	VGPU                *VGPU
+	DynamicDirectPathIO *DynamicDirectPathIO
+}
+
+// NIC describes a virtual network interface.
+type NIC struct {
+	// AddressType is the MAC address type: "manual", "generated" or "assigned".
+	AddressType string
+
+	// MacAddress is the MAC address, when AddressType is "manual".
+	MacAddress string
+}
+
+// Disk describes a virtual disk backed by a flat VMDK.
+type Disk struct {
+	// CapacityInBytes is the capacity of the disk.
+	CapacityInBytes int64
+
+	// FileName is the datastore path of the VMDK backing the disk.
+	FileName string
+
+	// DiskMode is the disk persistence mode, e.g. "persistent".
+	DiskMode string
+
+	// ThinProvisioned indicates whether the disk is thin provisioned.
+	ThinProvisioned *bool
+}
+
+// VGPU describes a vGPU, i.e. a PCI passthrough device with a vmiop backing.
+type VGPU struct {
+	// ProfileName is the name of the vGPU profile.
+	ProfileName string
+}
+
+// DynamicDirectPathIO describes a Dynamic DirectPath I/O device, i.e. a PCI passthrough device with a dynamic
+// backing.
+type DynamicDirectPathIO struct {
+	// AllowedDevices lists the physical devices the device may be backed by.
+	AllowedDevices []AllowedDevice
+
+	// CustomLabel is the custom label of the physical device the device is backed by.
+	CustomLabel string
+}
+
+// AllowedDevice identifies a physical PCI device by its vendor and device IDs.
+type AllowedDevice struct {
+	VendorID int32
+	DeviceID int32
+}
+
+// UnsupportedElement describes an element of a serialized vim.vm.ConfigSpec that is not typed by ConfigSpec.
+type UnsupportedElement struct {
+	// Path identifies the element in the vim.vm.ConfigSpec, e.g. "deviceChange[1].device".
+	Path string
+
+	// Type is the vim type name of the element, if it has one.
+	Type string
+}
+
+func (e UnsupportedElement) String() string {
+	if e.Type == "" {
+		return e.Path
+	}
+	return e.Path + " (" + e.Type + ")"
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package configspec
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestGoldenRoundTrip decodes each testdata fixture and compares its encoding with the matching golden file.  The
+// golden file must then decode to the same ConfigSpec, and encode to the same bytes.
+func TestGoldenRoundTrip(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fixture := range fixtures {
+		if strings.HasSuffix(fixture, ".golden.xml") {
+			continue
+		}
+		goldenFile := strings.TrimSuffix(fixture, ".xml") + ".golden.xml"
+
+		t.Run(filepath.Base(fixture), func(t *testing.T) {
+			spec := unmarshalFile(t, fixture)
+			encoded := Marshal(spec)
+
+			if *update {
+				if err := ioutil.WriteFile(goldenFile, append(encoded, '\n'), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			golden, err := ioutil.ReadFile(goldenFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden = bytes.TrimSuffix(golden, []byte("\n"))
+
+			if !bytes.Equal(encoded, golden) {
+				t.Fatalf("encoding of %s differs from %s:\ngot:  %s\nwant: %s", fixture, goldenFile, encoded, golden)
+			}
+
+			goldenSpec, unsupported, err := Unmarshal(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(unsupported) != 0 {
+				t.Errorf("golden file %s has unsupported elements: %v", goldenFile, unsupported)
+			}
+			if !reflect.DeepEqual(goldenSpec, sortedExtraConfig(spec)) {
+				t.Errorf("golden file %s decodes to %+v, want %+v", goldenFile, goldenSpec, spec)
+			}
+			if reencoded := Marshal(goldenSpec); !bytes.Equal(reencoded, golden) {
+				t.Errorf("encoding of %s is not stable:\ngot:  %s\nwant: %s", goldenFile, reencoded, golden)
+			}
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	spec := unmarshalFile(t, filepath.Join("testdata", "full.xml"))
+
+	decoded, unsupported, err := Decode(Encode(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsupported) != 0 {
+		t.Errorf("unexpected unsupported elements: %v", unsupported)
+	}
+	if !reflect.DeepEqual(decoded, sortedExtraConfig(spec)) {
+		t.Errorf("Decode(Encode()) = %+v, want %+v", decoded, spec)
+	}
+}
+
+func TestUnmarshalCPUAndAllocation(t *testing.T) {
+	spec := unmarshalFile(t, filepath.Join("testdata", "full.xml"))
+
+	if spec.NumCPUs != 4 || spec.NumCoresPerSocket != 2 || spec.MemoryMB != 16384 {
+		t.Errorf("NumCPUs, NumCoresPerSocket, MemoryMB = %d, %d, %d, want 4, 2, 16384",
+			spec.NumCPUs, spec.NumCoresPerSocket, spec.MemoryMB)
+	}
+	if spec.HTSharing != "none" || spec.LatencySensitivity != "high" {
+		t.Errorf("HTSharing, LatencySensitivity = %q, %q, want none, high", spec.HTSharing, spec.LatencySensitivity)
+	}
+	if spec.Firmware != "efi" {
+		t.Errorf("Firmware = %q, want efi", spec.Firmware)
+	}
+	if expected := (&Shares{Level: "custom", Shares: 4000}); !reflect.DeepEqual(spec.CPUShares, expected) {
+		t.Errorf("CPUShares = %+v, want %+v", spec.CPUShares, expected)
+	}
+	if expected := (&Shares{Level: "high"}); !reflect.DeepEqual(spec.MemoryShares, expected) {
+		t.Errorf("MemoryShares = %+v, want %+v", spec.MemoryShares, expected)
+	}
+
+	// An allocation without shares leaves the shares unset.
+	spec, unsupported, err := Unmarshal([]byte(`<obj><cpuAllocation><limit>100</limit></cpuAllocation></obj>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.CPUShares != nil {
+		t.Errorf("CPUShares = %+v, want nil", spec.CPUShares)
+	}
+	if expected := []UnsupportedElement{{Path: "cpuAllocation.limit"}}; !reflect.DeepEqual(unsupported, expected) {
+		t.Errorf("unsupported elements = %v, want %v", unsupported, expected)
+	}
+}
+
+func TestMarshalOrder(t *testing.T) {
+	thin := true
+	unitNumber := int32(0)
+	spec := &ConfigSpec{
+		ExtraConfig: []OptionValue{
+			{Key: "zeta", Value: "1"},
+			{Key: "alpha", Value: "2"},
+			{Key: "mu", Value: "3"},
+		},
+		DeviceChanges: []DeviceChange{
+			{
+				Operation: DeviceOperationAdd,
+				Device: VirtualDevice{
+					Kind:       DeviceKindDisk,
+					Key:        -200,
+					UnitNumber: &unitNumber,
+					Disk:       &Disk{CapacityInBytes: 1024 * 1024, ThinProvisioned: &thin},
+				},
+			},
+			{
+				Operation: DeviceOperationAdd,
+				Device:    VirtualDevice{Kind: DeviceKindVGPU, Key: -100, VGPU: &VGPU{ProfileName: "grid"}},
+			},
+		},
+		Firmware:           "efi",
+		LatencySensitivity: "high",
+		MemoryShares:       &Shares{Level: "normal"},
+		CPUShares:          &Shares{Level: "custom", Shares: 2000},
+		HTSharing:          "internal",
+		MemoryMB:           2048,
+		NumCoresPerSocket:  2,
+		NumCPUs:            2,
+	}
+
+	encoded := string(Marshal(spec))
+
+	// The elements are in the order of the vim schema, the DeviceChanges in their order, and the ExtraConfig
+	// sorted by key.
+	assertOrder(t, encoded,
+		"<flags", "<numCPUs>", "<numCoresPerSocket>", "<memoryMB>",
+		"<key>-200</key>", "<backing", "<unitNumber>", "<capacityInKB>", "<capacityInBytes>",
+		"<key>-100</key>",
+		"<cpuAllocation", "<memoryAllocation", "<latencySensitivity",
+		"<key>alpha</key>", "<key>mu</key>", "<key>zeta</key>", "<firmware>")
+
+	// The encoding does not depend on the order of the ExtraConfig.
+	spec.ExtraConfig[0], spec.ExtraConfig[2] = spec.ExtraConfig[2], spec.ExtraConfig[0]
+	if reordered := string(Marshal(spec)); reordered != encoded {
+		t.Errorf("encoding depends on the order of the ExtraConfig:\ngot:  %s\nwant: %s", reordered, encoded)
+	}
+	if spec.ExtraConfig[0].Key != "mu" {
+		t.Errorf("Marshal reordered the ExtraConfig of the ConfigSpec")
+	}
+}
+
+func TestUnmarshalUnsupported(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "unsupported.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec, unsupported, err := Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []UnsupportedElement{
+		{Path: "name"},
+		{Path: "guestId"},
+		{Path: "flags.enableLogging"},
+		{Path: "cpuAllocation.reservation"},
+		{Path: "deviceChange[0].device", Type: "VirtualUSBController"},
+		{Path: "deviceChange[1].profile", Type: "VirtualMachineDefinedProfileSpec"},
+		{Path: "deviceChange[1].device.backing.datastore"},
+		{Path: "deviceChange[1].device.storageIOAllocation", Type: "StorageIOAllocationInfo"},
+		{Path: "deviceChange[2].device.backing", Type: "VirtualPCIPassthroughDeviceBackingInfo"},
+		{Path: "deviceChange[2].device", Type: "VirtualPCIPassthrough"},
+		{Path: "extraConfig[0].extra"},
+	}
+	if !reflect.DeepEqual(unsupported, expected) {
+		t.Errorf("unsupported elements = %v, want %v", unsupported, expected)
+	}
+
+	// The supported elements are decoded, and the unsupported devices are dropped.
+	if spec.NumCPUs != 2 {
+		t.Errorf("NumCPUs = %d, want 2", spec.NumCPUs)
+	}
+	if len(spec.DeviceChanges) != 1 || spec.DeviceChanges[0].Device.Kind != DeviceKindDisk {
+		t.Errorf("DeviceChanges = %+v, want a single disk", spec.DeviceChanges)
+	}
+	if len(spec.ExtraConfig) != 1 || spec.ExtraConfig[0] != (OptionValue{Key: "guestinfo.a", Value: "1"}) {
+		t.Errorf("ExtraConfig = %+v, want guestinfo.a=1", spec.ExtraConfig)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tests := map[string]string{
+		"malformed":       `<obj>`,
+		"unexpected type": `<obj xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="VirtualMachine"/>`,
+		"invalid integer": `<obj><numCPUs>four</numCPUs></obj>`,
+		"missing device":  `<obj><deviceChange><operation>add</operation></deviceChange></obj>`,
+		"invalid shares":  `<obj><cpuAllocation><shares><shares>many</shares></shares></cpuAllocation></obj>`,
+		"invalid cores":   `<obj><numCoresPerSocket>two</numCoresPerSocket></obj>`,
+	}
+
+	for name, data := range tests {
+		if _, _, err := Unmarshal([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if _, _, err := Decode("not base64"); err == nil {
+		t.Errorf("expected an error decoding invalid base64")
+	}
+}
+
+func unmarshalFile(t *testing.T, path string) *ConfigSpec {
+	t.Helper()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, _, err := Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return spec
+}
+
+// sortedExtraConfig returns the ConfigSpec with its ExtraConfig sorted by key, as encoded by Marshal.
+func sortedExtraConfig(spec *ConfigSpec) *ConfigSpec {
+	sorted := *spec
+	sorted.ExtraConfig = append([]OptionValue(nil), spec.ExtraConfig...)
+	sort.SliceStable(sorted.ExtraConfig, func(i, j int) bool {
+		return sorted.ExtraConfig[i].Key < sorted.ExtraConfig[j].Key
+	})
+	return &sorted
+}
+
+func assertOrder(t *testing.T, s string, substrs ...string) {
+	t.Helper()
+
+	last := -1
+	for _, sub := range substrs {
+		i := strings.Index(s[last+1:], sub)
+		if i < 0 {
+			t.Fatalf("%q not found after position %d in %s", sub, last, s)
+		}
+		last += 1 + i
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package configspec
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// FromVirtualMachineConfigSpec returns the ConfigSpec described by a VirtualMachineConfigSpec, decoded from its XML
+// or converted from its Structured form.  The UnsupportedElements are only reported for the XML form.
+func FromVirtualMachineConfigSpec(vmConfigSpec *v1alpha1.VirtualMachineConfigSpec) (*ConfigSpec, []UnsupportedElement, error) {
+	switch {
+	case vmConfigSpec.XML != "" && vmConfigSpec.Structured != nil:
+		return nil, nil, fmt.Errorf("only one of xml and structured may be specified")
+	case vmConfigSpec.XML != "":
+		return Decode(vmConfigSpec.XML)
+	case vmConfigSpec.Structured != nil:
+		return FromStructured(vmConfigSpec.Structured), nil, nil
+	default:
+		return nil, nil, fmt.Errorf("one of xml and structured must be specified")
+	}
+}
+
+// XMLToStructured converts the base64-encoded, XML-serialized vim.vm.ConfigSpec of a VirtualMachineConfigSpec to
+// its Structured form.  The elements that cannot be represented by the Structured form are returned.
+func XMLToStructured(data string) (*v1alpha1.StructuredConfigSpec, []UnsupportedElement, error) {
+	spec, unsupported, err := Decode(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ToStructured(spec), unsupported, nil
+}
+
+// StructuredToXML converts the Structured form of a VirtualMachineConfigSpec to a base64-encoded, XML-serialized
+// vim.vm.ConfigSpec.
+func StructuredToXML(structured *v1alpha1.StructuredConfigSpec) string {
+	return Encode(FromStructured(structured))
+}
+
+// FromStructured converts the Structured form of a VirtualMachineConfigSpec to a ConfigSpec.  The ExtraConfig is
+// sorted by key.
+func FromStructured(structured *v1alpha1.StructuredConfigSpec) *ConfigSpec {
+	spec := &ConfigSpec{
+		NumCPUs:            structured.NumCPUs,
+		NumCoresPerSocket:  structured.NumCoresPerSocket,
+		MemoryMB:           structured.MemoryMB,
+		HTSharing:          string(structured.HyperthreadSharing),
+		CPUShares:          fromStructuredShares(structured.CpuShares),
+		MemoryShares:       fromStructuredShares(structured.MemoryShares),
+		LatencySensitivity: string(structured.LatencySensitivity),
+		Firmware:           string(structured.Firmware),
+	}
+
+	for _, dc := range structured.DeviceChanges {
+		spec.DeviceChanges = append(spec.DeviceChanges, DeviceChange{
+			Operation:     DeviceOperation(dc.Operation),
+			FileOperation: DeviceFileOperation(dc.FileOperation),
+			Device:        fromStructuredDevice(&dc.Device),
+		})
+	}
+
+	keys := make([]string, 0, len(structured.ExtraConfig))
+	for k := range structured.ExtraConfig {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		spec.ExtraConfig = append(spec.ExtraConfig, OptionValue{Key: k, Value: structured.ExtraConfig[k]})
+	}
+
+	return spec
+}
+
+func fromStructuredShares(shares *v1alpha1.VirtualMachineSharesSpec) *Shares {
+	if shares == nil {
+		return nil
+	}
+	return &Shares{Level: string(shares.Level), Shares: shares.Shares}
+}
+
+func fromStructuredDevice(d *v1alpha1.ConfigSpecDevice) VirtualDevice {
+	dev := VirtualDevice{
+		Type:          d.Type,
+		Key:           d.Key,
+		ControllerKey: d.ControllerKey,
+		UnitNumber:    d.UnitNumber,
+	}
+
+	switch {
+	case d.NIC != nil:
+		dev.Kind = DeviceKindNIC
+		dev.NIC = &NIC{AddressType: d.NIC.AddressType, MacAddress: d.NIC.MacAddress}
+	case d.Disk != nil:
+		dev.Kind = DeviceKindDisk
+		dev.Disk = &Disk{
+			CapacityInBytes: d.Disk.Capacity.Value(),
+			FileName:        d.Disk.FileName,
+			DiskMode:        d.Disk.DiskMode,
+			ThinProvisioned: d.Disk.ThinProvisioned,
+		}
+	case d.VGPU != nil:
+		dev.Kind = DeviceKindVGPU
+		dev.VGPU = &VGPU{ProfileName: d.VGPU.ProfileName}
+	case d.DynamicDirectPathIO != nil:
+		dev.Kind = DeviceKindDynamicDirectPathIO
+		dev.DynamicDirectPathIO = &DynamicDirectPathIO{CustomLabel: d.DynamicDirectPathIO.CustomLabel}
+		for _, ad := range d.DynamicDirectPathIO.AllowedDevices {
+			dev.DynamicDirectPathIO.AllowedDevices = append(dev.DynamicDirectPathIO.AllowedDevices,
+				AllowedDevice{VendorID: int32(ad.VendorID), DeviceID: int32(ad.DeviceID)})
+		}
+	}
+
+	if dev.Type == "" {
+		dev.Type = defaultDeviceType(dev.Kind)
+	}
+
+	return dev
+}
+
+// ToStructured converts a ConfigSpec to the Structured form of a VirtualMachineConfigSpec.  When the ExtraConfig
+// has duplicate keys, the last value wins.
+func ToStructured(spec *ConfigSpec) *v1alpha1.StructuredConfigSpec {
+	structured := &v1alpha1.StructuredConfigSpec{
+		NumCPUs:            spec.NumCPUs,
+		NumCoresPerSocket:  spec.NumCoresPerSocket,
+		MemoryMB:           spec.MemoryMB,
+		HyperthreadSharing: v1alpha1.VirtualMachineHyperthreadSharing(spec.HTSharing),
+		CpuShares:          ToStructuredShares(spec.CPUShares),
+		MemoryShares:       ToStructuredShares(spec.MemoryShares),
+		LatencySensitivity: v1alpha1.VirtualMachineLatencySensitivity(spec.LatencySensitivity),
+		Firmware:           v1alpha1.VirtualMachineFirmware(spec.Firmware),
+	}
+
+	for i := range spec.DeviceChanges {
+		dc := &spec.DeviceChanges[i]
+		structured.DeviceChanges = append(structured.DeviceChanges, v1alpha1.ConfigSpecDeviceChange{
+			Operation:     v1alpha1.ConfigSpecDeviceOperation(dc.Operation),
+			FileOperation: v1alpha1.ConfigSpecDeviceFileOperation(dc.FileOperation),
+			Device:        toStructuredDevice(&dc.Device),
+		})
+	}
+
+	if len(spec.ExtraConfig) > 0 {
+		structured.ExtraConfig = make(map[string]string, len(spec.ExtraConfig))
+		for _, ov := range spec.ExtraConfig {
+			structured.ExtraConfig[ov.Key] = ov.Value
+		}
+	}
+
+	return structured
+}
+
+func toStructuredDevice(dev *VirtualDevice) v1alpha1.ConfigSpecDevice {
+	d := v1alpha1.ConfigSpecDevice{
+		Type:          dev.Type,
+		Key:           dev.Key,
+		ControllerKey: dev.ControllerKey,
+		UnitNumber:    dev.UnitNumber,
+	}
+
+	switch {
+	case dev.NIC != nil:
+		d.NIC = &v1alpha1.ConfigSpecNIC{AddressType: dev.NIC.AddressType, MacAddress: dev.NIC.MacAddress}
+	case dev.Disk != nil:
+		d.Disk = &v1alpha1.ConfigSpecDisk{
+			Capacity:        *resource.NewQuantity(dev.Disk.CapacityInBytes, resource.BinarySI),
+			FileName:        dev.Disk.FileName,
+			DiskMode:        dev.Disk.DiskMode,
+			ThinProvisioned: dev.Disk.ThinProvisioned,
+		}
+	case dev.VGPU != nil:
+		d.VGPU = &v1alpha1.VGPUDevice{ProfileName: dev.VGPU.ProfileName}
+	case dev.DynamicDirectPathIO != nil:
+		d.DynamicDirectPathIO = &v1alpha1.ConfigSpecDynamicDirectPathIO{CustomLabel: dev.DynamicDirectPathIO.CustomLabel}
+		for _, ad := range dev.DynamicDirectPathIO.AllowedDevices {
+			d.DynamicDirectPathIO.AllowedDevices = append(d.DynamicDirectPathIO.AllowedDevices,
+				v1alpha1.DynamicDirectPathIODevice{VendorID: int(ad.VendorID), DeviceID: int(ad.DeviceID)})
+		}
+	}
+
+	return d
+}
+
+// ToStructuredShares converts the Shares of a ConfigSpec to a VirtualMachineSharesSpec.
+func ToStructuredShares(shares *Shares) *v1alpha1.VirtualMachineSharesSpec {
+	if shares == nil {
+		return nil
+	}
+	return &v1alpha1.VirtualMachineSharesSpec{
+		Level:  v1alpha1.VirtualMachineSharesLevel(shares.Level),
+		Shares: shares.Shares,
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package configspec
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+func TestStructuredRoundTrip(t *testing.T) {
+	spec := unmarshalFile(t, filepath.Join("testdata", "full.xml"))
+
+	structured := ToStructured(spec)
+	if got := FromStructured(structured); !reflect.DeepEqual(got, sortedExtraConfig(spec)) {
+		t.Errorf("FromStructured(ToStructured()) = %+v, want %+v", got, spec)
+	}
+}
+
+func TestXMLStructuredRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "full.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	structured, unsupported, err := XMLToStructured(base64.StdEncoding.EncodeToString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsupported) != 0 {
+		t.Errorf("unexpected unsupported elements: %v", unsupported)
+	}
+
+	if structured.NumCPUs != 4 || structured.MemoryMB != 16384 {
+		t.Errorf("NumCPUs, MemoryMB = %d, %d, want 4, 16384", structured.NumCPUs, structured.MemoryMB)
+	}
+	if structured.NumCoresPerSocket != 2 || structured.HyperthreadSharing != "none" ||
+		structured.LatencySensitivity != v1alpha1.LatencySensitivityHigh {
+		t.Errorf("NumCoresPerSocket, HyperthreadSharing, LatencySensitivity = %d, %s, %s, want 2, none, high",
+			structured.NumCoresPerSocket, structured.HyperthreadSharing, structured.LatencySensitivity)
+	}
+	if structured.Firmware != v1alpha1.EFIFirmware {
+		t.Errorf("Firmware = %s, want efi", structured.Firmware)
+	}
+	expectedCPUShares := &v1alpha1.VirtualMachineSharesSpec{Level: v1alpha1.SharesLevelCustom, Shares: 4000}
+	if !reflect.DeepEqual(structured.CpuShares, expectedCPUShares) {
+		t.Errorf("CpuShares = %+v, want %+v", structured.CpuShares, expectedCPUShares)
+	}
+	expectedMemoryShares := &v1alpha1.VirtualMachineSharesSpec{Level: v1alpha1.SharesLevelHigh}
+	if !reflect.DeepEqual(structured.MemoryShares, expectedMemoryShares) {
+		t.Errorf("MemoryShares = %+v, want %+v", structured.MemoryShares, expectedMemoryShares)
+	}
+	if len(structured.DeviceChanges) != 4 {
+		t.Fatalf("DeviceChanges = %+v, want 4 changes", structured.DeviceChanges)
+	}
+	if disk := structured.DeviceChanges[1].Device.Disk; disk == nil || disk.Capacity.Cmp(resource.MustParse("20Gi")) != 0 {
+		t.Errorf("Disk = %+v, want a capacity of 20Gi", disk)
+	}
+	if vgpu := structured.DeviceChanges[2].Device.VGPU; vgpu == nil || vgpu.ProfileName != "grid_v100-4q" {
+		t.Errorf("VGPU = %+v, want the grid_v100-4q profile", vgpu)
+	}
+	expectedExtraConfig := map[string]string{
+		"pciPassthru.use64bitMMIO": "TRUE",
+		"ctkEnabled":               "true",
+		"guestinfo.note":           "a <b> & c",
+	}
+	if !reflect.DeepEqual(structured.ExtraConfig, expectedExtraConfig) {
+		t.Errorf("ExtraConfig = %v, want %v", structured.ExtraConfig, expectedExtraConfig)
+	}
+
+	// The XML converted back from the Structured form is the canonical encoding of the original XML.
+	if got, want := StructuredToXML(structured), Encode(unmarshalFile(t, filepath.Join("testdata", "full.xml"))); got != want {
+		t.Errorf("StructuredToXML(XMLToStructured()) = %s, want %s", got, want)
+	}
+}
+
+func TestXMLToStructuredUnsupported(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "unsupported.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, unsupported, err := XMLToStructured(base64.StdEncoding.EncodeToString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsupported) == 0 {
+		t.Errorf("expected the unsupported elements to be reported")
+	}
+
+	if _, _, err := XMLToStructured("not base64"); err == nil {
+		t.Errorf("expected an error converting invalid base64")
+	}
+}
+
+func TestToStructuredDuplicateExtraConfig(t *testing.T) {
+	spec := &ConfigSpec{
+		ExtraConfig: []OptionValue{
+			{Key: "b", Value: "1"},
+			{Key: "a", Value: "2"},
+			{Key: "b", Value: "3"},
+		},
+	}
+
+	// The Structured form cannot represent duplicate keys: the last value wins, and the other values are lost.
+	structured := ToStructured(spec)
+	if expected := map[string]string{"a": "2", "b": "3"}; !reflect.DeepEqual(structured.ExtraConfig, expected) {
+		t.Errorf("ExtraConfig = %v, want %v", structured.ExtraConfig, expected)
+	}
+
+	expected := []OptionValue{{Key: "a", Value: "2"}, {Key: "b", Value: "3"}}
+	if got := FromStructured(structured).ExtraConfig; !reflect.DeepEqual(got, expected) {
+		t.Errorf("FromStructured(ToStructured()).ExtraConfig = %v, want %v", got, expected)
+	}
+}
+
+func TestFromStructuredDefaultDeviceType(t *testing.T) {
+	structured := &v1alpha1.StructuredConfigSpec{
+		DeviceChanges: []v1alpha1.ConfigSpecDeviceChange{
+			{Operation: v1alpha1.ConfigSpecDeviceOperationAdd, Device: v1alpha1.ConfigSpecDevice{NIC: &v1alpha1.ConfigSpecNIC{}}},
+			{Operation: v1alpha1.ConfigSpecDeviceOperationAdd, Device: v1alpha1.ConfigSpecDevice{
+				Disk: &v1alpha1.ConfigSpecDisk{Capacity: resource.MustParse("1Gi")}}},
+			{Operation: v1alpha1.ConfigSpecDeviceOperationAdd, Device: v1alpha1.ConfigSpecDevice{
+				VGPU: &v1alpha1.VGPUDevice{ProfileName: "grid"}}},
+			{Operation: v1alpha1.ConfigSpecDeviceOperationAdd, Device: v1alpha1.ConfigSpecDevice{
+				Type: "VirtualE1000e", NIC: &v1alpha1.ConfigSpecNIC{}}},
+		},
+	}
+
+	spec := FromStructured(structured)
+	expected := []string{"VirtualVmxnet3", "VirtualDisk", "VirtualPCIPassthrough", "VirtualE1000e"}
+	for i, dc := range spec.DeviceChanges {
+		if dc.Device.Type != expected[i] {
+			t.Errorf("DeviceChanges[%d].Device.Type = %q, want %q", i, dc.Device.Type, expected[i])
+		}
+	}
+}
+
+func TestFromVirtualMachineConfigSpec(t *testing.T) {
+	structured := &v1alpha1.StructuredConfigSpec{NumCPUs: 2}
+	xml := StructuredToXML(structured)
+
+	for name, vmConfigSpec := range map[string]*v1alpha1.VirtualMachineConfigSpec{
+		"xml":        {XML: xml},
+		"structured": {Structured: structured},
+	} {
+		spec, _, err := FromVirtualMachineConfigSpec(vmConfigSpec)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		} else if spec.NumCPUs != 2 {
+			t.Errorf("%s: NumCPUs = %d, want 2", name, spec.NumCPUs)
+		}
+	}
+
+	for name, vmConfigSpec := range map[string]*v1alpha1.VirtualMachineConfigSpec{
+		"both":    {XML: xml, Structured: structured},
+		"neither": {},
+	} {
+		if _, _, err := FromVirtualMachineConfigSpec(vmConfigSpec); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
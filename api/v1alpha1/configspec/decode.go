@@ -0,0 +1,447 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package configspec
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// nicTypes are the vim type names of the virtual network interfaces.
+var nicTypes = map[string]bool{
+	"VirtualE1000":             true,
+	"VirtualE1000e":            true,
+	"VirtualPCNet32":           true,
+	"VirtualSriovEthernetCard": true,
+	"VirtualVmxnet":            true,
+	"VirtualVmxnet2":           true,
+	"VirtualVmxnet3":           true,
+	"VirtualVmxnet3Vrdma":      true,
+}
+
+// node is a generic XML element, used to walk a serialized vim.vm.ConfigSpec.
+type node struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []node     `xml:",any"`
+}
+
+// xsiType returns the xsi:type of the element, without its namespace prefix.
+func (n *node) xsiType() string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == "type" && (a.Name.Space == xsiNamespace || a.Name.Space == "xsi") {
+			if i := strings.LastIndex(a.Value, ":"); i >= 0 {
+				return a.Value[i+1:]
+			}
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (n *node) text() string {
+	return strings.TrimSpace(n.Text)
+}
+
+// Decode decodes a base64-encoded, XML-serialized vim.vm.ConfigSpec, such as the XML of a
+// VirtualMachineConfigSpec.  See Unmarshal.
+func Decode(data string) (*ConfigSpec, []UnsupportedElement, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to base64 decode ConfigSpec: %w", err)
+	}
+	return Unmarshal(raw)
+}
+
+// Unmarshal decodes an XML-serialized vim.vm.ConfigSpec.  It returns the typed subset of the ConfigSpec, along with
+// the elements that are not part of this subset, which are otherwise ignored.  The name of the root element is not
+// significant.
+func Unmarshal(data []byte) (*ConfigSpec, []UnsupportedElement, error) {
+	var root node
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal ConfigSpec XML: %w", err)
+	}
+	if t := root.xsiType(); t != "" && t != VirtualMachineConfigSpecType {
+		return nil, nil, fmt.Errorf("unexpected ConfigSpec type %q", t)
+	}
+
+	d := &decoder{}
+	spec, err := d.configSpec(&root)
+	if err != nil {
+		return nil, nil, err
+	}
+	return spec, d.unsupported, nil
+}
+
+// decoder maps the generic XML elements of a vim.vm.ConfigSpec onto a ConfigSpec, recording the unsupported
+// elements.
+type decoder struct {
+	unsupported []UnsupportedElement
+}
+
+func (d *decoder) unsupportedElement(path string, n *node) {
+	d.unsupported = append(d.unsupported, UnsupportedElement{Path: path, Type: n.xsiType()})
+}
+
+func (d *decoder) configSpec(n *node) (*ConfigSpec, error) {
+	spec := &ConfigSpec{}
+	var numDeviceChanges, numExtraConfig int
+
+	for i := range n.Children {
+		c := &n.Children[i]
+		name := c.XMLName.Local
+
+		switch name {
+		case "numCPUs":
+			v, err := parseInt(c, name, 32)
+			if err != nil {
+				return nil, err
+			}
+			spec.NumCPUs = int32(v)
+
+		case "numCoresPerSocket":
+			v, err := parseInt(c, name, 32)
+			if err != nil {
+				return nil, err
+			}
+			spec.NumCoresPerSocket = int32(v)
+
+		case "memoryMB":
+			v, err := parseInt(c, name, 64)
+			if err != nil {
+				return nil, err
+			}
+			spec.MemoryMB = v
+
+		case "flags":
+			for j := range c.Children {
+				f := &c.Children[j]
+				if f.XMLName.Local == "htSharing" {
+					spec.HTSharing = f.text()
+				} else {
+					d.unsupportedElement(name+"."+f.XMLName.Local, f)
+				}
+			}
+
+		case "cpuAllocation", "memoryAllocation":
+			shares, err := d.allocationShares(c, name)
+			if err != nil {
+				return nil, err
+			}
+			if name == "cpuAllocation" {
+				spec.CPUShares = shares
+			} else {
+				spec.MemoryShares = shares
+			}
+
+		case "latencySensitivity":
+			for j := range c.Children {
+				l := &c.Children[j]
+				if l.XMLName.Local == "level" {
+					spec.LatencySensitivity = l.text()
+				} else {
+					d.unsupportedElement(name+"."+l.XMLName.Local, l)
+				}
+			}
+
+		case "firmware":
+			spec.Firmware = c.text()
+
+		case "deviceChange":
+			path := fmt.Sprintf("deviceChange[%d]", numDeviceChanges)
+			numDeviceChanges++
+			dc, err := d.deviceChange(c, path)
+			if err != nil {
+				return nil, err
+			}
+			if dc != nil {
+				spec.DeviceChanges = append(spec.DeviceChanges, *dc)
+			}
+
+		case "extraConfig":
+			path := fmt.Sprintf("extraConfig[%d]", numExtraConfig)
+			numExtraConfig++
+			spec.ExtraConfig = append(spec.ExtraConfig, d.optionValue(c, path))
+
+		default:
+			d.unsupportedElement(name, c)
+		}
+	}
+
+	return spec, nil
+}
+
+// allocationShares decodes the shares of a vim.ResourceAllocationInfo.  It returns nil when the shares are not
+// specified.
+func (d *decoder) allocationShares(n *node, path string) (*Shares, error) {
+	var shares *Shares
+
+	for i := range n.Children {
+		c := &n.Children[i]
+		if c.XMLName.Local != "shares" {
+			d.unsupportedElement(path+"."+c.XMLName.Local, c)
+			continue
+		}
+
+		shares = &Shares{}
+		for j := range c.Children {
+			s := &c.Children[j]
+			switch s.XMLName.Local {
+			case "level":
+				shares.Level = s.text()
+			case "shares":
+				v, err := parseInt(s, path+".shares.shares", 32)
+				if err != nil {
+					return nil, err
+				}
+				shares.Shares = int32(v)
+			default:
+				d.unsupportedElement(path+".shares."+s.XMLName.Local, s)
+			}
+		}
+	}
+
+	return shares, nil
+}
+
+func (d *decoder) optionValue(n *node, path string) OptionValue {
+	var ov OptionValue
+
+	for i := range n.Children {
+		c := &n.Children[i]
+		switch c.XMLName.Local {
+		case "key":
+			ov.Key = c.text()
+		case "value":
+			ov.Value = c.Text
+		default:
+			d.unsupportedElement(path+"."+c.XMLName.Local, c)
+		}
+	}
+
+	return ov
+}
+
+// deviceChange decodes a vim.vm.device.VirtualDeviceSpec.  It returns nil when the device is not supported.
+func (d *decoder) deviceChange(n *node, path string) (*DeviceChange, error) {
+	dc := &DeviceChange{}
+	var hasDevice bool
+
+	for i := range n.Children {
+		c := &n.Children[i]
+		name := c.XMLName.Local
+
+		switch name {
+		case "operation":
+			dc.Operation = DeviceOperation(c.text())
+		case "fileOperation":
+			dc.FileOperation = DeviceFileOperation(c.text())
+		case "device":
+			dev, err := d.device(c, path+".device")
+			if err != nil {
+				return nil, err
+			}
+			if dev == nil {
+				return nil, nil
+			}
+			dc.Device = *dev
+			hasDevice = true
+		default:
+			d.unsupportedElement(path+"."+name, c)
+		}
+	}
+
+	if !hasDevice {
+		return nil, fmt.Errorf("%s: missing device", path)
+	}
+
+	return dc, nil
+}
+
+// device decodes a vim.vm.device.VirtualDevice.  It returns nil when the device is not supported.
+func (d *decoder) device(n *node, path string) (*VirtualDevice, error) {
+	dev := &VirtualDevice{Type: n.xsiType()}
+
+	switch {
+	case nicTypes[dev.Type]:
+		dev.Kind, dev.NIC = DeviceKindNIC, &NIC{}
+	case dev.Type == "VirtualDisk":
+		dev.Kind, dev.Disk = DeviceKindDisk, &Disk{}
+	case dev.Type == "VirtualPCIPassthrough":
+		// The kind of a PCI passthrough device depends on its backing.
+	default:
+		d.unsupportedElement(path, n)
+		return nil, nil
+	}
+
+	var capacityInKB int64
+	for i := range n.Children {
+		c := &n.Children[i]
+		name := c.XMLName.Local
+		var err error
+
+		switch {
+		case name == "key":
+			var v int64
+			v, err = parseInt(c, path+".key", 32)
+			dev.Key = int32(v)
+		case name == "controllerKey":
+			dev.ControllerKey, err = parseInt32Ptr(c, path+".controllerKey")
+		case name == "unitNumber":
+			dev.UnitNumber, err = parseInt32Ptr(c, path+".unitNumber")
+		case name == "backing":
+			err = d.backing(dev, c, path+".backing")
+		case name == "addressType" && dev.NIC != nil:
+			dev.NIC.AddressType = c.text()
+		case name == "macAddress" && dev.NIC != nil:
+			dev.NIC.MacAddress = c.text()
+		case name == "capacityInBytes" && dev.Disk != nil:
+			dev.Disk.CapacityInBytes, err = parseInt(c, path+".capacityInBytes", 64)
+		case name == "capacityInKB" && dev.Disk != nil:
+			capacityInKB, err = parseInt(c, path+".capacityInKB", 64)
+		default:
+			d.unsupportedElement(path+"."+name, c)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if dev.Disk != nil && dev.Disk.CapacityInBytes == 0 {
+		dev.Disk.CapacityInBytes = capacityInKB * 1024
+	}
+
+	if dev.Kind == "" {
+		// A PCI passthrough device without a vmiop or a dynamic backing.
+		d.unsupportedElement(path, n)
+		return nil, nil
+	}
+
+	return dev, nil
+}
+
+func (d *decoder) backing(dev *VirtualDevice, n *node, path string) error {
+	typ := n.xsiType()
+
+	switch {
+	case dev.Disk != nil && typ == "VirtualDiskFlatVer2BackingInfo":
+		for i := range n.Children {
+			c := &n.Children[i]
+			switch c.XMLName.Local {
+			case "fileName":
+				dev.Disk.FileName = c.text()
+			case "diskMode":
+				dev.Disk.DiskMode = c.text()
+			case "thinProvisioned":
+				v, err := parseBool(c, path+".thinProvisioned")
+				if err != nil {
+					return err
+				}
+				dev.Disk.ThinProvisioned = &v
+			default:
+				d.unsupportedElement(path+"."+c.XMLName.Local, c)
+			}
+		}
+
+	case dev.Type == "VirtualPCIPassthrough" && typ == "VirtualPCIPassthroughVmiopBackingInfo":
+		dev.Kind, dev.VGPU = DeviceKindVGPU, &VGPU{}
+		for i := range n.Children {
+			c := &n.Children[i]
+			switch c.XMLName.Local {
+			case "vgpu":
+				dev.VGPU.ProfileName = c.text()
+			default:
+				d.unsupportedElement(path+"."+c.XMLName.Local, c)
+			}
+		}
+
+	case dev.Type == "VirtualPCIPassthrough" && typ == "VirtualPCIPassthroughDynamicBackingInfo":
+		dev.Kind, dev.DynamicDirectPathIO = DeviceKindDynamicDirectPathIO, &DynamicDirectPathIO{}
+		var numAllowedDevices int
+		for i := range n.Children {
+			c := &n.Children[i]
+			switch c.XMLName.Local {
+			case "deviceName", "useAutoDetect":
+				// Required by the vim.vm.device.VirtualDevice.DeviceBackingInfo, but not significant.
+			case "allowedDevice":
+				allowedPath := fmt.Sprintf("%s.allowedDevice[%d]", path, numAllowedDevices)
+				numAllowedDevices++
+				ad, err := d.allowedDevice(c, allowedPath)
+				if err != nil {
+					return err
+				}
+				dev.DynamicDirectPathIO.AllowedDevices = append(dev.DynamicDirectPathIO.AllowedDevices, ad)
+			case "customLabel":
+				dev.DynamicDirectPathIO.CustomLabel = c.text()
+			default:
+				d.unsupportedElement(path+"."+c.XMLName.Local, c)
+			}
+		}
+
+	default:
+		d.unsupportedElement(path, n)
+	}
+
+	return nil
+}
+
+func (d *decoder) allowedDevice(n *node, path string) (AllowedDevice, error) {
+	var ad AllowedDevice
+
+	for i := range n.Children {
+		c := &n.Children[i]
+		name := c.XMLName.Local
+		switch name {
+		case "vendorId", "deviceId":
+			v, err := parseInt(c, path+"."+name, 32)
+			if err != nil {
+				return ad, err
+			}
+			if name == "vendorId" {
+				ad.VendorID = int32(v)
+			} else {
+				ad.DeviceID = int32(v)
+			}
+		default:
+			d.unsupportedElement(path+"."+name, c)
+		}
+	}
+
+	return ad, nil
+}
+
+func parseInt(n *node, path string, bitSize int) (int64, error) {
+	v, err := strconv.ParseInt(n.text(), 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid integer %q: %w", path, n.text(), err)
+	}
+	return v, nil
+}
+
+func parseInt32Ptr(n *node, path string) (*int32, error) {
+	v, err := parseInt(n, path, 32)
+	if err != nil {
+		return nil, err
+	}
+	i := int32(v)
+	return &i, nil
+}
+
+func parseBool(n *node, path string) (bool, error) {
+	v, err := strconv.ParseBool(n.text())
+	if err != nil {
+		return false, fmt.Errorf("%s: invalid boolean %q: %w", path, n.text(), err)
+	}
+	return v, nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package configspec
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Encode encodes a ConfigSpec as a base64-encoded, XML-serialized vim.vm.ConfigSpec, suitable for the XML of a
+// VirtualMachineConfigSpec.  See Marshal.
+func Encode(spec *ConfigSpec) string {
+	return base64.StdEncoding.EncodeToString(Marshal(spec))
+}
+
+// Marshal encodes a ConfigSpec as an XML-serialized vim.vm.ConfigSpec.  The encoding is deterministic: the elements
+// are written in the order of the vim schema, the DeviceChanges in their order and the ExtraConfig sorted by key,
+// so that equal ConfigSpecs are encoded identically.
+func Marshal(spec *ConfigSpec) []byte {
+	e := &encoder{}
+
+	e.WriteString(`<obj xmlns:vim25="urn:vim25" xmlns:xsd="` + xsdNamespace + `" xmlns:xsi="` + xsiNamespace + `"`)
+	e.WriteString(` xsi:type="vim25:` + VirtualMachineConfigSpecType + `">`)
+
+	if spec.HTSharing != "" {
+		e.start("flags", "VirtualMachineFlagInfo")
+		e.element("htSharing", "", spec.HTSharing)
+		e.end("flags")
+	}
+	if spec.NumCPUs != 0 {
+		e.element("numCPUs", "", strconv.FormatInt(int64(spec.NumCPUs), 10))
+	}
+	if spec.NumCoresPerSocket != 0 {
+		e.element("numCoresPerSocket", "", strconv.FormatInt(int64(spec.NumCoresPerSocket), 10))
+	}
+	if spec.MemoryMB != 0 {
+		e.element("memoryMB", "", strconv.FormatInt(spec.MemoryMB, 10))
+	}
+
+	for i := range spec.DeviceChanges {
+		e.deviceChange(&spec.DeviceChanges[i])
+	}
+
+	e.allocationShares("cpuAllocation", spec.CPUShares)
+	e.allocationShares("memoryAllocation", spec.MemoryShares)
+	if spec.LatencySensitivity != "" {
+		e.start("latencySensitivity", "LatencySensitivity")
+		e.element("level", "", spec.LatencySensitivity)
+		e.end("latencySensitivity")
+	}
+
+	extraConfig := make([]OptionValue, len(spec.ExtraConfig))
+	copy(extraConfig, spec.ExtraConfig)
+	sort.SliceStable(extraConfig, func(i, j int) bool { return extraConfig[i].Key < extraConfig[j].Key })
+	for _, ov := range extraConfig {
+		e.start("extraConfig", "OptionValue")
+		e.element("key", "", ov.Key)
+		e.element("value", "xsd:string", ov.Value)
+		e.end("extraConfig")
+	}
+
+	if spec.Firmware != "" {
+		e.element("firmware", "", spec.Firmware)
+	}
+
+	e.end("obj")
+	return e.Bytes()
+}
+
+type encoder struct {
+	bytes.Buffer
+}
+
+// start writes the start tag of an element.  A vim xsiType is prefixed with the vim25 namespace prefix, unless it
+// already has a prefix.
+func (e *encoder) start(name, xsiType string) {
+	e.WriteString("<" + name)
+	if xsiType != "" {
+		if !strings.Contains(xsiType, ":") {
+			xsiType = "vim25:" + xsiType
+		}
+		e.WriteString(` xsi:type="` + xsiType + `"`)
+	}
+	e.WriteString(">")
+}
+
+func (e *encoder) end(name string) {
+	e.WriteString("</" + name + ">")
+}
+
+func (e *encoder) element(name, xsiType, text string) {
+	e.start(name, xsiType)
+	// Writing to a bytes.Buffer does not fail.
+	_ = xml.EscapeText(e, []byte(text))
+	e.end(name)
+}
+
+func (e *encoder) int32Element(name string, v *int32) {
+	if v != nil {
+		e.element(name, "", strconv.FormatInt(int64(*v), 10))
+	}
+}
+
+func (e *encoder) allocationShares(name string, shares *Shares) {
+	if shares == nil {
+		return
+	}
+	e.start(name, "ResourceAllocationInfo")
+	e.start("shares", "SharesInfo")
+	e.element("shares", "", strconv.FormatInt(int64(shares.Shares), 10))
+	e.element("level", "", shares.Level)
+	e.end("shares")
+	e.end(name)
+}
+
+func (e *encoder) deviceChange(dc *DeviceChange) {
+	e.start("deviceChange", "VirtualDeviceConfigSpec")
+	if dc.Operation != "" {
+		e.element("operation", "", string(dc.Operation))
+	}
+	if dc.FileOperation != "" {
+		e.element("fileOperation", "", string(dc.FileOperation))
+	}
+	e.device(&dc.Device)
+	e.end("deviceChange")
+}
+
+func (e *encoder) device(dev *VirtualDevice) {
+	typ := dev.Type
+	if typ == "" {
+		typ = defaultDeviceType(dev.Kind)
+	}
+
+	e.start("device", typ)
+	e.element("key", "", strconv.FormatInt(int64(dev.Key), 10))
+
+	switch {
+	case dev.Disk != nil:
+		e.start("backing", "VirtualDiskFlatVer2BackingInfo")
+		e.element("fileName", "", dev.Disk.FileName)
+		if dev.Disk.DiskMode != "" {
+			e.element("diskMode", "", dev.Disk.DiskMode)
+		}
+		if dev.Disk.ThinProvisioned != nil {
+			e.element("thinProvisioned", "", strconv.FormatBool(*dev.Disk.ThinProvisioned))
+		}
+		e.end("backing")
+	case dev.VGPU != nil:
+		e.start("backing", "VirtualPCIPassthroughVmiopBackingInfo")
+		e.element("vgpu", "", dev.VGPU.ProfileName)
+		e.end("backing")
+	case dev.DynamicDirectPathIO != nil:
+		e.start("backing", "VirtualPCIPassthroughDynamicBackingInfo")
+		e.element("deviceName", "", "")
+		for _, ad := range dev.DynamicDirectPathIO.AllowedDevices {
+			e.start("allowedDevice", "VirtualPCIPassthroughAllowedDevice")
+			e.element("vendorId", "", strconv.FormatInt(int64(ad.VendorID), 10))
+			e.element("deviceId", "", strconv.FormatInt(int64(ad.DeviceID), 10))
+			e.end("allowedDevice")
+		}
+		if dev.DynamicDirectPathIO.CustomLabel != "" {
+			e.element("customLabel", "", dev.DynamicDirectPathIO.CustomLabel)
+		}
+		e.end("backing")
+	}
+
+	e.int32Element("controllerKey", dev.ControllerKey)
+	e.int32Element("unitNumber", dev.UnitNumber)
+
+	switch {
+	case dev.NIC != nil:
+		if dev.NIC.AddressType != "" {
+			e.element("addressType", "", dev.NIC.AddressType)
+		}
+		if dev.NIC.MacAddress != "" {
+			e.element("macAddress", "", dev.NIC.MacAddress)
+		}
+	case dev.Disk != nil:
+		e.element("capacityInKB", "", strconv.FormatInt(dev.Disk.CapacityInBytes/1024, 10))
+		e.element("capacityInBytes", "", strconv.FormatInt(dev.Disk.CapacityInBytes, 10))
+	}
+
+	e.end("device")
+}
+
+// defaultDeviceType returns the vim type name of a device of the given kind whose Type is not set.
+func defaultDeviceType(kind DeviceKind) string {
+	switch kind {
+	case DeviceKindNIC:
+		return "VirtualVmxnet3"
+	case DeviceKindDisk:
+		return "VirtualDisk"
+	default:
+		return "VirtualPCIPassthrough"
+	}
+}
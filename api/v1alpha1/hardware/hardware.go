@@ -0,0 +1,415 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hardware computes the effective virtual hardware of a VirtualMachine from the sources that contribute to
+// it, so that the result can be previewed before the VirtualMachine is created.
+//
+// The sources are merged with the following precedence, from highest to lowest:
+//
+//   - The VirtualMachine spec: the AdvancedOptions override the firmware, the vTPM, the default disk provisioning
+//     and the change block tracking, and the AdditionalDevices are appended to the devices.
+//   - The VirtualMachineClass ConfigSpec: its number of CPUs and cores per socket, memory size, hyperthread sharing,
+//     CPU and memory shares, latency sensitivity, NUMA node affinity, vGPU and Dynamic DirectPath I/O devices and
+//     extra configuration override the VirtualMachineClass Hardware, and its firmware is used.
+//   - The VirtualMachineClass Hardware and Policies.
+//   - The VirtualMachineImage: its HardwareVersion is used, unless the devices require a more recent one.
+//
+// Every setting that is specified by more than one source with different values is reported as a Conflict, along
+// with the settings that are overridden or adjusted to satisfy the requirements of other settings.
+package hardware
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/configspec"
+)
+
+// Minimum virtual hardware versions required by the virtual devices.
+const (
+	// MinHardwareVersionPCIPassthrough is the minimum hardware version required by vGPU and Dynamic DirectPath I/O
+	// devices.
+	MinHardwareVersionPCIPassthrough int32 = 17
+
+	// MinHardwareVersionVTPM is the minimum hardware version required by a virtual Trusted Platform Module.
+	MinHardwareVersionVTPM int32 = 14
+)
+
+// Extra configuration keys.
+const (
+	// ChangeBlockTrackingKey is the extra configuration key that enables change block tracking.
+	ChangeBlockTrackingKey = "ctkEnabled"
+
+	// NUMANodeAffinityKey is the extra configuration key that lists the host NUMA nodes, separated by commas, the
+	// virtual machine may be scheduled on.
+	NUMANodeAffinityKey = "numa.nodeAffinity"
+)
+
+// Source identifies a source of the effective hardware.
+type Source string
+
+const (
+	SourceVirtualMachine = Source("VirtualMachine")
+	SourceConfigSpec     = Source("VirtualMachineClass ConfigSpec")
+	SourceClass          = Source("VirtualMachineClass")
+	SourceImage          = Source("VirtualMachineImage")
+
+	// SourceRequirements identifies the settings adjusted to satisfy the requirements of the devices.
+	SourceRequirements = Source("Requirements")
+)
+
+// Conflict describes a setting that is specified by more than one source with different values, or that is
+// adjusted to satisfy the requirements of other settings.
+type Conflict struct {
+	// Field identifies the setting, e.g. "cpus".
+	Field string
+
+	// Source is the source whose value is used.
+	Source Source
+
+	// Overridden is the source whose value is not used.
+	Overridden Source
+
+	// Message is a human readable message describing the conflict.
+	Message string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: %s overrides %s: %s", c.Field, c.Source, c.Overridden, c.Message)
+}
+
+// Effective is the effective virtual hardware of a VirtualMachine.
+type Effective struct {
+	// Cpus is the number of virtual CPUs.
+	Cpus int64
+
+	// CoresPerSocket is the number of cores per virtual CPU socket.  Zero means a single core per socket.
+	CoresPerSocket int32
+
+	// NUMA describes the NUMA topology, or is nil when it is derived from the host.
+	NUMA *v1alpha1.VirtualMachineNUMASpec
+
+	// HyperthreadSharing describes how the virtual CPUs may share physical cores.  Empty means "any".
+	HyperthreadSharing v1alpha1.VirtualMachineHyperthreadSharing
+
+	// LatencySensitivity is the latency sensitivity.  Empty means "normal".
+	LatencySensitivity v1alpha1.VirtualMachineLatencySensitivity
+
+	// CpuShares are the CPU shares, or nil for the default shares.
+	CpuShares *v1alpha1.VirtualMachineSharesSpec
+
+	// MemoryShares are the memory shares, or nil for the default shares.
+	MemoryShares *v1alpha1.VirtualMachineSharesSpec
+
+	// Memory is the size of the memory.
+	Memory resource.Quantity
+
+	// HardwareVersion is the virtual hardware version.  Zero means the default of the vSphere infrastructure.
+	HardwareVersion int32
+
+	// Firmware is the firmware interface.  Empty means the default of the guest OS.
+	Firmware v1alpha1.VirtualMachineFirmware
+
+	// VirtualTPM indicates whether a virtual Trusted Platform Module is added.
+	VirtualTPM bool
+
+	// Devices are the vGPU and Dynamic DirectPath I/O devices.
+	Devices v1alpha1.VirtualDevices
+
+	// InstanceStorage describes the instance storage volumes.
+	InstanceStorage v1alpha1.InstanceStorage
+
+	// Provisioning is the default provisioning of the disks exclusively owned by the VirtualMachine.
+	Provisioning v1alpha1.VirtualMachineVolumeProvisioningOptions
+
+	// Resources are the CPU and memory reservations and limits.
+	Resources v1alpha1.VirtualMachineClassResources
+
+	// ChangeBlockTracking indicates whether change block tracking is enabled.
+	ChangeBlockTracking bool
+
+	// ExtraConfig are the additional configuration key/value pairs.
+	ExtraConfig map[string]string
+}
+
+// Compute returns the effective virtual hardware of a VirtualMachine that uses vmClass and image, along with the
+// conflicts detected between the sources.  The image may be nil.  An error is returned when the ConfigSpec of the
+// VirtualMachineClass cannot be decoded.
+func Compute(
+	vm *v1alpha1.VirtualMachine,
+	vmClass *v1alpha1.VirtualMachineClass,
+	image *v1alpha1.VirtualMachineImage) (*Effective, []Conflict, error) {
+
+	hw := &vmClass.Spec.Hardware
+	c := &computer{
+		effective: &Effective{
+			Cpus:               hw.Cpus,
+			CoresPerSocket:     hw.CoresPerSocket,
+			NUMA:               hw.NUMA.DeepCopy(),
+			HyperthreadSharing: hw.HyperthreadSharing,
+			LatencySensitivity: hw.LatencySensitivity,
+			CpuShares:          hw.CpuShares.DeepCopy(),
+			MemoryShares:       hw.MemoryShares.DeepCopy(),
+			Memory:             hw.Memory.DeepCopy(),
+			Devices:            *hw.Devices.DeepCopy(),
+			InstanceStorage:    *hw.InstanceStorage.DeepCopy(),
+			Resources:          *vmClass.Spec.Policies.Resources.DeepCopy(),
+			ExtraConfig:        map[string]string{},
+		},
+	}
+
+	if vmClass.Spec.ConfigSpec != nil {
+		spec, _, err := configspec.FromVirtualMachineConfigSpec(vmClass.Spec.ConfigSpec)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode ConfigSpec of VirtualMachineClass %s: %w", vmClass.Name, err)
+		}
+		c.applyConfigSpec(spec)
+	}
+
+	if image != nil {
+		c.effective.HardwareVersion = image.Spec.HardwareVersion
+	}
+
+	c.applyVirtualMachine(&vm.Spec)
+	c.applyRequirements()
+
+	return c.effective, c.conflicts, nil
+}
+
+type computer struct {
+	effective *Effective
+	conflicts []Conflict
+}
+
+func (c *computer) conflict(field string, source, overridden Source, format string, args ...interface{}) {
+	c.conflicts = append(c.conflicts, Conflict{
+		Field:      field,
+		Source:     source,
+		Overridden: overridden,
+		Message:    fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *computer) applyConfigSpec(spec *configspec.ConfigSpec) {
+	e := c.effective
+
+	if spec.NumCPUs != 0 {
+		if e.Cpus != 0 && e.Cpus != int64(spec.NumCPUs) {
+			c.conflict("cpus", SourceConfigSpec, SourceClass, "%d CPUs instead of %d", spec.NumCPUs, e.Cpus)
+		}
+		e.Cpus = int64(spec.NumCPUs)
+	}
+
+	if spec.NumCoresPerSocket != 0 {
+		if e.CoresPerSocket != 0 && e.CoresPerSocket != spec.NumCoresPerSocket {
+			c.conflict("coresPerSocket", SourceConfigSpec, SourceClass, "%d cores per socket instead of %d",
+				spec.NumCoresPerSocket, e.CoresPerSocket)
+		}
+		e.CoresPerSocket = spec.NumCoresPerSocket
+	}
+
+	if spec.MemoryMB != 0 {
+		memory := *resource.NewQuantity(spec.MemoryMB*1024*1024, resource.BinarySI)
+		if !e.Memory.IsZero() && e.Memory.Cmp(memory) != 0 {
+			c.conflict("memory", SourceConfigSpec, SourceClass, "%s of memory instead of %s",
+				memory.String(), e.Memory.String())
+		}
+		e.Memory = memory
+	}
+
+	var devices v1alpha1.VirtualDevices
+	for _, dc := range spec.DeviceChanges {
+		if dc.Operation != configspec.DeviceOperationAdd {
+			continue
+		}
+		dev := dc.Device
+		switch {
+		case dev.VGPU != nil:
+			devices.VGPUDevices = append(devices.VGPUDevices, v1alpha1.VGPUDevice{ProfileName: dev.VGPU.ProfileName})
+		case dev.DynamicDirectPathIO != nil:
+			// The device is described by the first of its allowed devices.
+			if ads := dev.DynamicDirectPathIO.AllowedDevices; len(ads) > 0 {
+				devices.DynamicDirectPathIODevices = append(devices.DynamicDirectPathIODevices,
+					v1alpha1.DynamicDirectPathIODevice{
+						VendorID:    int(ads[0].VendorID),
+						DeviceID:    int(ads[0].DeviceID),
+						CustomLabel: dev.DynamicDirectPathIO.CustomLabel,
+					})
+			}
+		case dev.Disk != nil && dev.Disk.ThinProvisioned != nil && e.Provisioning.ThinProvisioned == nil:
+			thin := *dev.Disk.ThinProvisioned
+			e.Provisioning.ThinProvisioned = &thin
+		}
+	}
+
+	// The PCI devices of the ConfigSpec replace the devices of the VirtualMachineClass Hardware.
+	if len(devices.VGPUDevices)+len(devices.DynamicDirectPathIODevices) > 0 {
+		if len(e.Devices.VGPUDevices)+len(e.Devices.DynamicDirectPathIODevices) > 0 &&
+			!apiequality.Semantic.DeepEqual(devices, e.Devices) {
+			c.conflict("devices", SourceConfigSpec, SourceClass, "the devices of the ConfigSpec are used")
+		}
+		e.Devices = devices
+	}
+
+	if spec.HTSharing != "" {
+		htSharing := v1alpha1.VirtualMachineHyperthreadSharing(spec.HTSharing)
+		if e.HyperthreadSharing != "" && e.HyperthreadSharing != htSharing {
+			c.conflict("hyperthreadSharing", SourceConfigSpec, SourceClass, "hyperthread sharing is %s instead of %s",
+				htSharing, e.HyperthreadSharing)
+		}
+		e.HyperthreadSharing = htSharing
+	}
+
+	if spec.LatencySensitivity != "" {
+		level := v1alpha1.VirtualMachineLatencySensitivity(spec.LatencySensitivity)
+		if e.LatencySensitivity != "" && e.LatencySensitivity != level {
+			c.conflict("latencySensitivity", SourceConfigSpec, SourceClass, "latency sensitivity is %s instead of %s",
+				level, e.LatencySensitivity)
+		}
+		e.LatencySensitivity = level
+	}
+
+	if spec.Firmware != "" {
+		e.Firmware = v1alpha1.VirtualMachineFirmware(spec.Firmware)
+	}
+
+	if spec.CPUShares != nil {
+		shares := configspec.ToStructuredShares(spec.CPUShares)
+		if e.CpuShares != nil && !apiequality.Semantic.DeepEqual(shares, e.CpuShares) {
+			c.conflict("cpuShares", SourceConfigSpec, SourceClass, "the CPU shares of the ConfigSpec are used")
+		}
+		e.CpuShares = shares
+	}
+	if spec.MemoryShares != nil {
+		shares := configspec.ToStructuredShares(spec.MemoryShares)
+		if e.MemoryShares != nil && !apiequality.Semantic.DeepEqual(shares, e.MemoryShares) {
+			c.conflict("memoryShares", SourceConfigSpec, SourceClass, "the memory shares of the ConfigSpec are used")
+		}
+		e.MemoryShares = shares
+	}
+
+	for _, ov := range spec.ExtraConfig {
+		e.ExtraConfig[ov.Key] = ov.Value
+	}
+	if v, ok := e.ExtraConfig[ChangeBlockTrackingKey]; ok {
+		e.ChangeBlockTracking, _ = strconv.ParseBool(v)
+	}
+	if v, ok := e.ExtraConfig[NUMANodeAffinityKey]; ok {
+		c.applyNUMANodeAffinity(v)
+	}
+}
+
+// applyNUMANodeAffinity overrides the NUMA node affinity with the value of the NUMANodeAffinityKey of the ConfigSpec.
+// A value that is not a list of NUMA nodes is left to be rejected by vSphere.
+func (c *computer) applyNUMANodeAffinity(value string) {
+	e := c.effective
+
+	var nodes []int32
+	for _, n := range strings.Split(value, ",") {
+		node, err := strconv.ParseInt(strings.TrimSpace(n), 10, 32)
+		if err != nil {
+			return
+		}
+		nodes = append(nodes, int32(node))
+	}
+
+	if e.NUMA == nil {
+		e.NUMA = &v1alpha1.VirtualMachineNUMASpec{}
+	}
+	if len(e.NUMA.NodeAffinity) > 0 && !apiequality.Semantic.DeepEqual(nodes, e.NUMA.NodeAffinity) {
+		c.conflict("numa.nodeAffinity", SourceConfigSpec, SourceClass, "NUMA node affinity is %s instead of %v",
+			value, e.NUMA.NodeAffinity)
+	}
+	e.NUMA.NodeAffinity = nodes
+}
+
+func (c *computer) applyVirtualMachine(spec *v1alpha1.VirtualMachineSpec) {
+	e := c.effective
+
+	if opts := spec.AdvancedOptions; opts != nil {
+		if opts.Firmware != "" {
+			if e.Firmware != "" && e.Firmware != opts.Firmware {
+				c.conflict("firmware", SourceVirtualMachine, SourceConfigSpec, "firmware is %s instead of %s",
+					opts.Firmware, e.Firmware)
+			}
+			e.Firmware = opts.Firmware
+		}
+		if opts.VirtualTPM != nil {
+			e.VirtualTPM = *opts.VirtualTPM
+		}
+
+		if p := opts.DefaultVolumeProvisioningOptions; p != nil {
+			if p.ThinProvisioned != nil && e.Provisioning.ThinProvisioned != nil &&
+				*p.ThinProvisioned != *e.Provisioning.ThinProvisioned {
+				c.conflict("provisioning.thinProvisioned", SourceVirtualMachine, SourceConfigSpec,
+					"thinProvisioned is %t", *p.ThinProvisioned)
+			}
+			if p.ThinProvisioned != nil {
+				e.Provisioning.ThinProvisioned = p.ThinProvisioned
+			}
+			e.Provisioning.EagerZeroed = p.EagerZeroed
+		}
+
+		if cbt := opts.ChangeBlockTracking; cbt != nil {
+			if v, ok := e.ExtraConfig[ChangeBlockTrackingKey]; ok && v != strconv.FormatBool(*cbt) {
+				c.conflict("changeBlockTracking", SourceVirtualMachine, SourceConfigSpec,
+					"change block tracking is %t", *cbt)
+			}
+			e.ChangeBlockTracking = *cbt
+			e.ExtraConfig[ChangeBlockTrackingKey] = strconv.FormatBool(*cbt)
+		}
+	}
+
+	if d := spec.AdditionalDevices; d != nil {
+		e.Devices.VGPUDevices = append(e.Devices.VGPUDevices, d.VGPUDevices...)
+		e.Devices.DynamicDirectPathIODevices = append(e.Devices.DynamicDirectPathIODevices,
+			d.DynamicDirectPathIODevices...)
+	}
+}
+
+// applyRequirements adjusts the settings required by other settings.
+func (c *computer) applyRequirements() {
+	e := c.effective
+
+	hasPCIDevices := len(e.Devices.VGPUDevices)+len(e.Devices.DynamicDirectPathIODevices) > 0
+
+	minHardwareVersion := int32(0)
+	if hasPCIDevices {
+		minHardwareVersion = MinHardwareVersionPCIPassthrough
+	} else if e.VirtualTPM {
+		minHardwareVersion = MinHardwareVersionVTPM
+	}
+	if e.HardwareVersion != 0 && e.HardwareVersion < minHardwareVersion {
+		c.conflict("hardwareVersion", SourceRequirements, SourceImage,
+			"hardware version %d is raised to %d, as required by the devices", e.HardwareVersion, minHardwareVersion)
+		e.HardwareVersion = minHardwareVersion
+	}
+
+	// The memory of a VirtualMachine with PCI passthrough devices or a high latency sensitivity must be fully
+	// reserved, and so must the CPU of a VirtualMachine with a high latency sensitivity.
+	highLatencySensitivity := e.LatencySensitivity == v1alpha1.LatencySensitivityHigh
+	if (hasPCIDevices || highLatencySensitivity) && e.Resources.Requests.Memory.Cmp(e.Memory) != 0 {
+		requiredBy := "PCI passthrough devices"
+		if !hasPCIDevices {
+			requiredBy = "high latency sensitivity"
+		}
+		c.conflict("resources.requests.memory", SourceRequirements, SourceClass,
+			"memory reservation of %s is raised to %s, as required by the %s",
+			e.Resources.Requests.Memory.String(), e.Memory.String(), requiredBy)
+		e.Resources.Requests.Memory = e.Memory.DeepCopy()
+	}
+	if highLatencySensitivity {
+		cpus := *resource.NewQuantity(e.Cpus, resource.DecimalSI)
+		if e.Resources.Requests.Cpu.Cmp(cpus) != 0 {
+			c.conflict("resources.requests.cpu", SourceRequirements, SourceClass,
+				"CPU reservation of %s is raised to %s, as required by the high latency sensitivity",
+				e.Resources.Requests.Cpu.String(), cpus.String())
+			e.Resources.Requests.Cpu = cpus
+		}
+	}
+}
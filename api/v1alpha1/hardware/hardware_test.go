@@ -0,0 +1,383 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package hardware
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/configspec"
+)
+
+func TestComputeClassHardware(t *testing.T) {
+	vmClass := newClass(2, "4Gi")
+	vmClass.Spec.Policies.Resources.Requests.Memory = resource.MustParse("1Gi")
+
+	hw, conflicts, err := Compute(&v1alpha1.VirtualMachine{}, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertConflicts(t, conflicts)
+	if hw.Cpus != 2 || hw.Memory.Cmp(resource.MustParse("4Gi")) != 0 {
+		t.Errorf("Cpus, Memory = %d, %s, want 2, 4Gi", hw.Cpus, hw.Memory.String())
+	}
+	if hw.Resources.Requests.Memory.Cmp(resource.MustParse("1Gi")) != 0 {
+		t.Errorf("Resources.Requests.Memory = %s, want 1Gi", hw.Resources.Requests.Memory.String())
+	}
+	if hw.HardwareVersion != 0 {
+		t.Errorf("HardwareVersion = %d, want 0 without an image", hw.HardwareVersion)
+	}
+}
+
+func TestComputeConfigSpecOverridesHardware(t *testing.T) {
+	vmClass := newClass(2, "4Gi")
+	vmClass.Spec.Hardware.Devices.VGPUDevices = []v1alpha1.VGPUDevice{{ProfileName: "grid-a"}}
+	vmClass.Spec.Policies.Resources.Requests.Memory = resource.MustParse("8Gi")
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{
+		Structured: &v1alpha1.StructuredConfigSpec{
+			NumCPUs:  4,
+			MemoryMB: 8192,
+			DeviceChanges: []v1alpha1.ConfigSpecDeviceChange{
+				{
+					Operation: v1alpha1.ConfigSpecDeviceOperationAdd,
+					Device:    v1alpha1.ConfigSpecDevice{VGPU: &v1alpha1.VGPUDevice{ProfileName: "grid-b"}},
+				},
+			},
+			ExtraConfig: map[string]string{ChangeBlockTrackingKey: "true", "guestinfo.a": "1"},
+		},
+	}
+
+	hw, conflicts, err := Compute(&v1alpha1.VirtualMachine{}, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertConflicts(t, conflicts, "cpus", "memory", "devices")
+	for _, c := range conflicts {
+		if c.Source != SourceConfigSpec || c.Overridden != SourceClass {
+			t.Errorf("conflict %s, want the ConfigSpec to override the VirtualMachineClass", c)
+		}
+	}
+
+	if hw.Cpus != 4 || hw.Memory.Cmp(resource.MustParse("8Gi")) != 0 {
+		t.Errorf("Cpus, Memory = %d, %s, want 4, 8Gi", hw.Cpus, hw.Memory.String())
+	}
+	if expected := []v1alpha1.VGPUDevice{{ProfileName: "grid-b"}}; !reflect.DeepEqual(hw.Devices.VGPUDevices, expected) {
+		t.Errorf("VGPUDevices = %v, want %v", hw.Devices.VGPUDevices, expected)
+	}
+	if !hw.ChangeBlockTracking || hw.ExtraConfig["guestinfo.a"] != "1" {
+		t.Errorf("ChangeBlockTracking, ExtraConfig = %t, %v, want the ExtraConfig of the ConfigSpec",
+			hw.ChangeBlockTracking, hw.ExtraConfig)
+	}
+}
+
+func TestComputeAdvancedOptionsOverrideConfigSpec(t *testing.T) {
+	thin, cbt, vTPM := false, false, true
+
+	vmClass := newClass(2, "4Gi")
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{
+		XML: configspec.Encode(&configspec.ConfigSpec{
+			DeviceChanges: []configspec.DeviceChange{
+				{
+					Operation: configspec.DeviceOperationAdd,
+					Device: configspec.VirtualDevice{
+						Kind: configspec.DeviceKindDisk,
+						Disk: &configspec.Disk{CapacityInBytes: 1024 * 1024 * 1024, ThinProvisioned: boolPtr(true)},
+					},
+				},
+			},
+			ExtraConfig: []configspec.OptionValue{{Key: ChangeBlockTrackingKey, Value: "true"}},
+		}),
+	}
+
+	vm := &v1alpha1.VirtualMachine{
+		Spec: v1alpha1.VirtualMachineSpec{
+			AdvancedOptions: &v1alpha1.VirtualMachineAdvancedOptions{
+				DefaultVolumeProvisioningOptions: &v1alpha1.VirtualMachineVolumeProvisioningOptions{ThinProvisioned: &thin},
+				ChangeBlockTracking:              &cbt,
+				Firmware:                         v1alpha1.EFIFirmware,
+				VirtualTPM:                       &vTPM,
+			},
+			AdditionalDevices: &v1alpha1.VirtualDevices{
+				DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{{VendorID: 4318, DeviceID: 7864}},
+			},
+		},
+	}
+
+	hw, conflicts, err := Compute(vm, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertConflicts(t, conflicts, "provisioning.thinProvisioned", "changeBlockTracking", "resources.requests.memory")
+	for _, c := range conflicts[:2] {
+		if c.Source != SourceVirtualMachine || c.Overridden != SourceConfigSpec {
+			t.Errorf("conflict %s, want the VirtualMachine to override the ConfigSpec", c)
+		}
+	}
+
+	if hw.Provisioning.ThinProvisioned == nil || *hw.Provisioning.ThinProvisioned {
+		t.Errorf("Provisioning.ThinProvisioned = %v, want false", hw.Provisioning.ThinProvisioned)
+	}
+	if hw.ChangeBlockTracking || hw.ExtraConfig[ChangeBlockTrackingKey] != "false" {
+		t.Errorf("ChangeBlockTracking, ExtraConfig = %t, %v, want change block tracking to be disabled",
+			hw.ChangeBlockTracking, hw.ExtraConfig)
+	}
+	if hw.Firmware != v1alpha1.EFIFirmware || !hw.VirtualTPM {
+		t.Errorf("Firmware, VirtualTPM = %s, %t, want efi, true", hw.Firmware, hw.VirtualTPM)
+	}
+	if len(hw.Devices.DynamicDirectPathIODevices) != 1 {
+		t.Errorf("DynamicDirectPathIODevices = %v, want the AdditionalDevices", hw.Devices.DynamicDirectPathIODevices)
+	}
+}
+
+func TestComputeHardwareVersion(t *testing.T) {
+	vTPM := true
+
+	tests := []struct {
+		name            string
+		hwVersion       int32
+		vm              v1alpha1.VirtualMachineSpec
+		expected        int32
+		expectConflicts bool
+	}{
+		{
+			name:      "image",
+			hwVersion: 13,
+			expected:  13,
+		},
+		{
+			name:            "vGPU",
+			hwVersion:       13,
+			vm:              v1alpha1.VirtualMachineSpec{AdditionalDevices: vgpuDevices("grid")},
+			expected:        MinHardwareVersionPCIPassthrough,
+			expectConflicts: true,
+		},
+		{
+			name:            "vTPM",
+			hwVersion:       13,
+			vm:              v1alpha1.VirtualMachineSpec{AdvancedOptions: &v1alpha1.VirtualMachineAdvancedOptions{VirtualTPM: &vTPM}},
+			expected:        MinHardwareVersionVTPM,
+			expectConflicts: true,
+		},
+		{
+			name:      "recent image",
+			hwVersion: 19,
+			vm:        v1alpha1.VirtualMachineSpec{AdditionalDevices: vgpuDevices("grid")},
+			expected:  19,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vmClass := newClass(2, "4Gi")
+			// Reserve the memory, so that only the hardware version is adjusted.
+			vmClass.Spec.Policies.Resources.Requests.Memory = resource.MustParse("4Gi")
+			image := &v1alpha1.VirtualMachineImage{Spec: v1alpha1.VirtualMachineImageSpec{HardwareVersion: tt.hwVersion}}
+
+			hw, conflicts, err := Compute(&v1alpha1.VirtualMachine{Spec: tt.vm}, vmClass, image)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if hw.HardwareVersion != tt.expected {
+				t.Errorf("HardwareVersion = %d, want %d", hw.HardwareVersion, tt.expected)
+			}
+			if tt.expectConflicts {
+				assertConflicts(t, conflicts, "hardwareVersion")
+				if conflicts[0].Source != SourceRequirements || conflicts[0].Overridden != SourceImage {
+					t.Errorf("conflict %s, want the requirements to override the VirtualMachineImage", conflicts[0])
+				}
+			} else {
+				assertConflicts(t, conflicts)
+			}
+		})
+	}
+}
+
+func TestComputeReservation(t *testing.T) {
+	vmClass := newClass(2, "4Gi")
+	vmClass.Spec.Policies.Resources.Requests.Memory = resource.MustParse("1Gi")
+
+	hw, conflicts, err := Compute(&v1alpha1.VirtualMachine{
+		Spec: v1alpha1.VirtualMachineSpec{AdditionalDevices: vgpuDevices("grid")},
+	}, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertConflicts(t, conflicts, "resources.requests.memory")
+	if conflicts[0].Source != SourceRequirements || conflicts[0].Overridden != SourceClass {
+		t.Errorf("conflict %s, want the requirements to override the VirtualMachineClass", conflicts[0])
+	}
+	if hw.Resources.Requests.Memory.Cmp(resource.MustParse("4Gi")) != 0 {
+		t.Errorf("Resources.Requests.Memory = %s, want the full memory of 4Gi", hw.Resources.Requests.Memory.String())
+	}
+
+	// The reservation of the VirtualMachineClass is not modified.
+	if vmClass.Spec.Policies.Resources.Requests.Memory.Cmp(resource.MustParse("1Gi")) != 0 {
+		t.Errorf("Compute modified the VirtualMachineClass")
+	}
+}
+
+func TestComputeConfigSpecOverridesTopology(t *testing.T) {
+	vmClass := newClass(8, "16Gi")
+	hw := &vmClass.Spec.Hardware
+	hw.CoresPerSocket = 2
+	hw.NUMA = &v1alpha1.VirtualMachineNUMASpec{NodeAffinity: []int32{0}, CoresPerNUMANode: 4}
+	hw.HyperthreadSharing = v1alpha1.HyperthreadSharingAny
+	hw.LatencySensitivity = v1alpha1.LatencySensitivityNormal
+	hw.CpuShares = &v1alpha1.VirtualMachineSharesSpec{Level: v1alpha1.SharesLevelNormal}
+	hw.MemoryShares = &v1alpha1.VirtualMachineSharesSpec{Level: v1alpha1.SharesLevelHigh}
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{
+		Structured: &v1alpha1.StructuredConfigSpec{
+			NumCoresPerSocket:  4,
+			HyperthreadSharing: v1alpha1.HyperthreadSharingNone,
+			LatencySensitivity: v1alpha1.LatencySensitivityLow,
+			CpuShares:          &v1alpha1.VirtualMachineSharesSpec{Level: v1alpha1.SharesLevelCustom, Shares: 4000},
+			MemoryShares:       &v1alpha1.VirtualMachineSharesSpec{Level: v1alpha1.SharesLevelHigh},
+			ExtraConfig:        map[string]string{NUMANodeAffinityKey: "1, 2"},
+		},
+	}
+
+	effective, conflicts, err := Compute(&v1alpha1.VirtualMachine{}, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The memory shares of the ConfigSpec are the same as those of the VirtualMachineClass.
+	assertConflicts(t, conflicts,
+		"coresPerSocket", "hyperthreadSharing", "latencySensitivity", "cpuShares", "numa.nodeAffinity")
+
+	if effective.CoresPerSocket != 4 || effective.HyperthreadSharing != v1alpha1.HyperthreadSharingNone ||
+		effective.LatencySensitivity != v1alpha1.LatencySensitivityLow {
+		t.Errorf("CoresPerSocket, HyperthreadSharing, LatencySensitivity = %d, %s, %s, want 4, none, low",
+			effective.CoresPerSocket, effective.HyperthreadSharing, effective.LatencySensitivity)
+	}
+	if effective.CpuShares == nil || effective.CpuShares.Shares != 4000 {
+		t.Errorf("CpuShares = %+v, want 4000 custom shares", effective.CpuShares)
+	}
+	expectedNUMA := &v1alpha1.VirtualMachineNUMASpec{NodeAffinity: []int32{1, 2}, CoresPerNUMANode: 4}
+	if !reflect.DeepEqual(effective.NUMA, expectedNUMA) {
+		t.Errorf("NUMA = %+v, want %+v", effective.NUMA, expectedNUMA)
+	}
+
+	// The VirtualMachineClass is not modified.
+	if !reflect.DeepEqual(hw.NUMA.NodeAffinity, []int32{0}) {
+		t.Errorf("Compute modified the NUMA of the VirtualMachineClass")
+	}
+}
+
+func TestComputeInvalidNUMANodeAffinity(t *testing.T) {
+	vmClass := newClass(2, "4Gi")
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{
+		Structured: &v1alpha1.StructuredConfigSpec{ExtraConfig: map[string]string{NUMANodeAffinityKey: "0,x"}},
+	}
+
+	// The invalid value is left to be rejected by vSphere.
+	hw, conflicts, err := Compute(&v1alpha1.VirtualMachine{}, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertConflicts(t, conflicts)
+	if hw.NUMA != nil {
+		t.Errorf("NUMA = %+v, want nil", hw.NUMA)
+	}
+}
+
+func TestComputeHighLatencySensitivityReservation(t *testing.T) {
+	vmClass := newClass(4, "8Gi")
+	vmClass.Spec.Policies.Resources.Requests.Cpu = resource.MustParse("1")
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{
+		Structured: &v1alpha1.StructuredConfigSpec{LatencySensitivity: v1alpha1.LatencySensitivityHigh},
+	}
+
+	hw, conflicts, err := Compute(&v1alpha1.VirtualMachine{}, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertConflicts(t, conflicts, "resources.requests.memory", "resources.requests.cpu")
+	if hw.Resources.Requests.Cpu.Cmp(resource.MustParse("4")) != 0 ||
+		hw.Resources.Requests.Memory.Cmp(resource.MustParse("8Gi")) != 0 {
+		t.Errorf("Resources.Requests = %s, %s, want the full 4 CPUs and 8Gi of memory",
+			hw.Resources.Requests.Cpu.String(), hw.Resources.Requests.Memory.String())
+	}
+}
+
+func TestComputeFirmware(t *testing.T) {
+	vmClass := newClass(2, "4Gi")
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{
+		XML: configspec.Encode(&configspec.ConfigSpec{Firmware: string(v1alpha1.BIOSFirmware)}),
+	}
+
+	hw, conflicts, err := Compute(&v1alpha1.VirtualMachine{}, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertConflicts(t, conflicts)
+	if hw.Firmware != v1alpha1.BIOSFirmware {
+		t.Errorf("Firmware = %s, want the bios firmware of the ConfigSpec", hw.Firmware)
+	}
+
+	vm := &v1alpha1.VirtualMachine{}
+	vm.Spec.AdvancedOptions = &v1alpha1.VirtualMachineAdvancedOptions{Firmware: v1alpha1.EFIFirmware}
+	hw, conflicts, err = Compute(vm, vmClass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertConflicts(t, conflicts, "firmware")
+	if hw.Firmware != v1alpha1.EFIFirmware {
+		t.Errorf("Firmware = %s, want the efi firmware of the AdvancedOptions", hw.Firmware)
+	}
+}
+
+func TestComputeInvalidConfigSpec(t *testing.T) {
+	vmClass := newClass(2, "4Gi")
+	vmClass.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{XML: "not base64"}
+
+	if _, _, err := Compute(&v1alpha1.VirtualMachine{}, vmClass, nil); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func newClass(cpus int64, memory string) *v1alpha1.VirtualMachineClass {
+	vmClass := &v1alpha1.VirtualMachineClass{}
+	vmClass.Name = "my-class"
+	vmClass.Spec.Hardware.Cpus = cpus
+	vmClass.Spec.Hardware.Memory = resource.MustParse(memory)
+	return vmClass
+}
+
+func vgpuDevices(profileNames ...string) *v1alpha1.VirtualDevices {
+	devices := &v1alpha1.VirtualDevices{}
+	for _, p := range profileNames {
+		devices.VGPUDevices = append(devices.VGPUDevices, v1alpha1.VGPUDevice{ProfileName: p})
+	}
+	return devices
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// assertConflicts asserts that the conflicts are on the given fields, in order.
+func assertConflicts(t *testing.T, conflicts []Conflict, fields ...string) {
+	t.Helper()
+
+	actual := make([]string, 0, len(conflicts))
+	for _, c := range conflicts {
+		actual = append(actual, c.Field)
+	}
+	if len(fields) == 0 {
+		fields = []string{}
+	}
+	if !reflect.DeepEqual(actual, fields) {
+		t.Fatalf("conflicts = %v, want conflicts on %v", conflicts, fields)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=nsvmclass
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="CPU",type="string",JSONPath=".spec.hardware.cpus"
+// +kubebuilder:printcolumn:name="Memory",type="string",JSONPath=".spec.hardware.memory"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="VGPUDevicesProfileNames",type="string",priority=1,JSONPath=".spec.hardware.devices.vgpuDevices[*].profileName"
+// +kubebuilder:printcolumn:name="PassthroughDeviceIDs",type="string",priority=1,JSONPath=".spec.hardware.devices.dynamicDirectPathIODevices[*].deviceID"
+// +kubebuilder:printcolumn:name="CoresPerSocket",type="integer",priority=1,JSONPath=".spec.hardware.coresPerSocket"
+// +kubebuilder:printcolumn:name="HyperthreadSharing",type="string",priority=1,JSONPath=".spec.hardware.hyperthreadSharing"
+// +kubebuilder:printcolumn:name="NUMANodeAffinity",type="string",priority=1,JSONPath=".spec.hardware.numa.nodeAffinity"
+// +kubebuilder:printcolumn:name="CoresPerNUMANode",type="integer",priority=1,JSONPath=".spec.hardware.numa.coresPerNUMANode"
+// +kubebuilder:printcolumn:name="LatencySensitivity",type="string",priority=1,JSONPath=".spec.hardware.latencySensitivity"
+
+// NamespacedVirtualMachineClass is the Schema for the namespacedvirtualmachineclasses API.
+// A NamespacedVirtualMachineClass is a VirtualMachineClass that is only available to the namespace it is created in,
+// without a VirtualMachineClassBinding.  It allows tenant-specific classes to be managed by the users of the namespace.
+// When a VirtualMachine references a ClassName, a NamespacedVirtualMachineClass with that name takes precedence over
+// a VirtualMachineClass bound to the namespace.
+type NamespacedVirtualMachineClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineClassSpec   `json:"spec,omitempty"`
+	Status VirtualMachineClassStatus `json:"status,omitempty"`
+}
+
+func (vmClass *NamespacedVirtualMachineClass) NamespacedName() string {
+	return vmClass.Namespace + "/" + vmClass.Name
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedVirtualMachineClassList contains a list of NamespacedVirtualMachineClass.
+type NamespacedVirtualMachineClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedVirtualMachineClass `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&NamespacedVirtualMachineClass{}, &NamespacedVirtualMachineClassList{})
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PauseSpec describes a request to stop VM Operator from reconciling an object with the vSphere infrastructure.
+type PauseSpec struct {
+	// Reason is a human readable message describing why reconciliation is paused.
+	Reason string `json:"reason"`
+
+	// Requester identifies the user or system that requested the pause, e.g. the name of a backup product.
+	// +optional
+	Requester string `json:"requester,omitempty"`
+
+	// Until is the time at which reconciliation automatically resumes.  When unset, reconciliation is paused until
+	// this PauseSpec is removed.
+	// +optional
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// Pausable is implemented by the objects whose reconciliation can be paused, either with the PauseAnnotation or
+// with a PauseSpec.
+// +kubebuilder:object:generate=false
+type Pausable interface {
+	metav1.Object
+
+	// GetPause returns the PauseSpec of the object, or nil when none is set.
+	GetPause() *PauseSpec
+}
+
+// IsPaused returns true when reconciliation of the object is paused, i.e. when the object has the PauseAnnotation,
+// or when it has a PauseSpec that has not expired.
+func IsPaused(obj Pausable) bool {
+	if _, ok := obj.GetAnnotations()[PauseAnnotation]; ok {
+		return true
+	}
+
+	pause := obj.GetPause()
+	if pause == nil {
+		return false
+	}
+
+	now := metav1.Now()
+	return pause.Until == nil || now.Before(pause.Until)
+}
+
+// PauseUntil returns the time at which reconciliation of a paused object automatically resumes.  It returns nil when
+// the object is not paused, or when it is paused indefinitely, such as with the PauseAnnotation.
+func PauseUntil(obj Pausable) *metav1.Time {
+	if _, ok := obj.GetAnnotations()[PauseAnnotation]; ok {
+		return nil
+	}
+
+	if !IsPaused(obj) {
+		return nil
+	}
+
+	return obj.GetPause().Until
+}
+
+func (vm *VirtualMachine) GetPause() *PauseSpec {
+	return vm.Spec.Pause
+}
+
+func (s *VirtualMachineService) GetPause() *PauseSpec {
+	return s.Spec.Pause
+}
+
+func (res *VirtualMachineSetResourcePolicy) GetPause() *PauseSpec {
+	return res.Spec.Pause
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quota accounts for the virtual hardware consumed by the VirtualMachines of a namespace, in the resource
+// names tracked by a VirtualMachineQuota, so that admission can reject the VirtualMachines that would exceed the
+// hard limits of the namespace.
+package quota
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/hardware"
+)
+
+// VirtualMachineUsage returns the resources consumed by a VirtualMachine that uses the VirtualMachineClass with the
+// given spec, e.g. as resolved by the vmclass package.  The hardware is the effective hardware computed by the
+// hardware package, so that the ConfigSpec and the AdditionalDevices are accounted for.  A zero CPU or memory limit
+// means the resource is unlimited, so that the VirtualMachine may consume all of its virtual CPUs or memory: it is
+// accounted for as a limit equal to the number of virtual CPUs, in cores, or the size of the memory.
+func VirtualMachineUsage(vm *v1alpha1.VirtualMachine, classSpec *v1alpha1.VirtualMachineClassSpec) (corev1.ResourceList, error) {
+	vmClass := &v1alpha1.VirtualMachineClass{Spec: *classSpec}
+	vmClass.Name = vm.EffectiveClassName()
+
+	hw, _, err := hardware.Compute(vm, vmClass, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cpus := *resource.NewQuantity(hw.Cpus, resource.DecimalSI)
+
+	limitsCPU := hw.Resources.Limits.Cpu.DeepCopy()
+	if limitsCPU.IsZero() {
+		limitsCPU = cpus.DeepCopy()
+	}
+	limitsMemory := hw.Resources.Limits.Memory.DeepCopy()
+	if limitsMemory.IsZero() {
+		limitsMemory = hw.Memory.DeepCopy()
+	}
+
+	usage := corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaVirtualMachines: *resource.NewQuantity(1, resource.DecimalSI),
+		v1alpha1.VirtualMachineQuotaCPUs:            cpus,
+		v1alpha1.VirtualMachineQuotaMemory:          hw.Memory.DeepCopy(),
+		v1alpha1.VirtualMachineQuotaRequestsCPU:     hw.Resources.Requests.Cpu.DeepCopy(),
+		v1alpha1.VirtualMachineQuotaLimitsCPU:       limitsCPU,
+		v1alpha1.VirtualMachineQuotaRequestsMemory:  hw.Resources.Requests.Memory.DeepCopy(),
+		v1alpha1.VirtualMachineQuotaLimitsMemory:    limitsMemory,
+		v1alpha1.VirtualMachineQuotaVGPUs:           *resource.NewQuantity(int64(len(hw.Devices.VGPUDevices)), resource.DecimalSI),
+		v1alpha1.VirtualMachineQuotaDynamicDirectPathIODevices: *resource.NewQuantity(
+			int64(len(hw.Devices.DynamicDirectPathIODevices)), resource.DecimalSI),
+	}
+
+	for _, d := range hw.Devices.VGPUDevices {
+		add(usage, v1alpha1.VirtualMachineQuotaVGPUProfile(d.ProfileName), *resource.NewQuantity(1, resource.DecimalSI))
+	}
+
+	instanceStorage := resource.NewQuantity(0, resource.BinarySI)
+	for _, v := range hw.InstanceStorage.Volumes {
+		instanceStorage.Add(v.Size)
+	}
+	usage[v1alpha1.VirtualMachineQuotaInstanceStorage] = *instanceStorage
+
+	return usage, nil
+}
+
+// NamespaceUsage returns the aggregate resources consumed by the VirtualMachines, with classSpecs mapping the
+// EffectiveClassName of each VirtualMachine to the spec of the class it resolves to in the namespace.
+// VirtualMachines that are being deleted are not accounted for.  A VirtualMachine that specifies Resources, and for
+// which no class is selected yet, is accounted for by its Resources, the lower bound of the usage of the class that
+// will be selected.  An error is returned when the class of a VirtualMachine is missing.
+func NamespaceUsage(
+	vms []v1alpha1.VirtualMachine,
+	classSpecs map[string]*v1alpha1.VirtualMachineClassSpec) (corev1.ResourceList, error) {
+
+	total := corev1.ResourceList{}
+
+	for i := range vms {
+		vm := &vms[i]
+		if vm.DeletionTimestamp != nil {
+			continue
+		}
+
+		if vm.EffectiveClassName() == "" {
+			for name, q := range requirementsUsage(vm.Spec.Resources) {
+				add(total, name, q)
+			}
+			continue
+		}
+
+		classSpec, ok := classSpecs[vm.EffectiveClassName()]
+		if !ok {
+			return nil, fmt.Errorf("VirtualMachineClass %s of VirtualMachine %s is not resolved",
+				vm.EffectiveClassName(), vm.NamespacedName())
+		}
+
+		usage, err := VirtualMachineUsage(vm, classSpec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute usage of VirtualMachine %s: %w", vm.NamespacedName(), err)
+		}
+		for name, q := range usage {
+			add(total, name, q)
+		}
+	}
+
+	return total, nil
+}
+
+// Exceeded returns the names of the resources, sorted, whose hard limit would be exceeded if a VirtualMachine
+// consuming oldUsage were changed to consume newUsage.  The used resources include oldUsage, as returned by
+// NamespaceUsage, and oldUsage is nil when the VirtualMachine is being created.  Only the resources that are
+// limited and whose consumption increases are considered, so that a VirtualMachine is not rejected because of a
+// pre-existing overage it does not contribute to.
+func Exceeded(hard, used, oldUsage, newUsage corev1.ResourceList) []corev1.ResourceName {
+	var exceeded []corev1.ResourceName
+
+	for name, limit := range hard {
+		delta, ok := newUsage[name]
+		if !ok {
+			continue
+		}
+		delta = delta.DeepCopy()
+		if q, ok := oldUsage[name]; ok {
+			delta.Sub(q)
+		}
+		if delta.Sign() <= 0 {
+			continue
+		}
+
+		total := delta
+		if u, ok := used[name]; ok {
+			total.Add(u)
+		}
+		if total.Cmp(limit) > 0 {
+			exceeded = append(exceeded, name)
+		}
+	}
+
+	sort.Slice(exceeded, func(i, j int) bool { return exceeded[i] < exceeded[j] })
+	return exceeded
+}
+
+// Mask returns the subset of the resources whose names are in hard, e.g. to populate the Used of a
+// VirtualMachineQuotaStatus.  Resources that are limited but not used are reported with a zero quantity.
+func Mask(resources, hard corev1.ResourceList) corev1.ResourceList {
+	masked := corev1.ResourceList{}
+
+	for name := range hard {
+		if q, ok := resources[name]; ok {
+			masked[name] = q.DeepCopy()
+		} else {
+			masked[name] = *resource.NewQuantity(0, resource.DecimalSI)
+		}
+	}
+
+	return masked
+}
+
+// requirementsUsage returns the resources consumed by a VirtualMachine for which no class is selected yet: the CPUs,
+// memory and devices of its Resources.  The reservations, limits and instance storage depend on the class, and are
+// not accounted for.
+func requirementsUsage(req *v1alpha1.VirtualMachineResourceRequirements) corev1.ResourceList {
+	if req == nil {
+		req = &v1alpha1.VirtualMachineResourceRequirements{}
+	}
+
+	return corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaVirtualMachines: *resource.NewQuantity(1, resource.DecimalSI),
+		v1alpha1.VirtualMachineQuotaCPUs:            *resource.NewQuantity(req.Cpus, resource.DecimalSI),
+		v1alpha1.VirtualMachineQuotaMemory:          req.Memory.DeepCopy(),
+		v1alpha1.VirtualMachineQuotaVGPUs:           *resource.NewQuantity(int64(req.VGPUDevices), resource.DecimalSI),
+		v1alpha1.VirtualMachineQuotaDynamicDirectPathIODevices: *resource.NewQuantity(
+			int64(req.DynamicDirectPathIODevices), resource.DecimalSI),
+	}
+}
+
+func add(list corev1.ResourceList, name corev1.ResourceName, q resource.Quantity) {
+	if cur, ok := list[name]; ok {
+		cur.Add(q)
+		list[name] = cur
+	} else {
+		list[name] = q.DeepCopy()
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+func TestVirtualMachineUsage(t *testing.T) {
+	classSpec := newClassSpec(2, "4Gi")
+	classSpec.Policies.Resources.Requests = v1alpha1.VirtualMachineResourceSpec{
+		Cpu:    resource.MustParse("500m"),
+		Memory: resource.MustParse("1Gi"),
+	}
+	classSpec.Policies.Resources.Limits = v1alpha1.VirtualMachineResourceSpec{
+		Cpu:    resource.MustParse("1"),
+		Memory: resource.MustParse("2Gi"),
+	}
+	classSpec.Hardware.InstanceStorage.Volumes = []v1alpha1.InstanceStorageVolume{
+		{Size: resource.MustParse("10Gi")},
+		{Size: resource.MustParse("20Gi")},
+	}
+
+	usage, err := VirtualMachineUsage(newVM("a", "small"), classSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertUsage(t, usage, corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaVirtualMachines:            resource.MustParse("1"),
+		v1alpha1.VirtualMachineQuotaCPUs:                       resource.MustParse("2"),
+		v1alpha1.VirtualMachineQuotaMemory:                     resource.MustParse("4Gi"),
+		v1alpha1.VirtualMachineQuotaRequestsCPU:                resource.MustParse("500m"),
+		v1alpha1.VirtualMachineQuotaLimitsCPU:                  resource.MustParse("1"),
+		v1alpha1.VirtualMachineQuotaRequestsMemory:             resource.MustParse("1Gi"),
+		v1alpha1.VirtualMachineQuotaLimitsMemory:               resource.MustParse("2Gi"),
+		v1alpha1.VirtualMachineQuotaVGPUs:                      resource.MustParse("0"),
+		v1alpha1.VirtualMachineQuotaDynamicDirectPathIODevices: resource.MustParse("0"),
+		v1alpha1.VirtualMachineQuotaInstanceStorage:            resource.MustParse("30Gi"),
+	})
+}
+
+func TestVirtualMachineUsageDevices(t *testing.T) {
+	vm := newVM("a", "small")
+	vm.Spec.AdditionalDevices = &v1alpha1.VirtualDevices{
+		VGPUDevices:                []v1alpha1.VGPUDevice{{ProfileName: "grid-a"}, {ProfileName: "grid-b"}, {ProfileName: "grid-a"}},
+		DynamicDirectPathIODevices: []v1alpha1.DynamicDirectPathIODevice{{VendorID: 4318, DeviceID: 7864}},
+	}
+
+	usage, err := VirtualMachineUsage(vm, newClassSpec(2, "4Gi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertUsage(t, usage, corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaVGPUs:                      resource.MustParse("3"),
+		v1alpha1.VirtualMachineQuotaVGPUProfile("grid-a"):      resource.MustParse("2"),
+		v1alpha1.VirtualMachineQuotaVGPUProfile("grid-b"):      resource.MustParse("1"),
+		v1alpha1.VirtualMachineQuotaDynamicDirectPathIODevices: resource.MustParse("1"),
+		// The memory of a VirtualMachine with PCI passthrough devices is fully reserved.
+		v1alpha1.VirtualMachineQuotaRequestsMemory: resource.MustParse("4Gi"),
+	})
+}
+
+func TestVirtualMachineUsageUnlimited(t *testing.T) {
+	usage, err := VirtualMachineUsage(newVM("a", "small"), newClassSpec(2, "4Gi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A VirtualMachine without limits may consume all of its virtual CPUs and memory.
+	assertUsage(t, usage, corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaRequestsCPU:    resource.MustParse("0"),
+		v1alpha1.VirtualMachineQuotaLimitsCPU:      resource.MustParse("2"),
+		v1alpha1.VirtualMachineQuotaRequestsMemory: resource.MustParse("0"),
+		v1alpha1.VirtualMachineQuotaLimitsMemory:   resource.MustParse("4Gi"),
+	})
+}
+
+func TestNamespaceUsage(t *testing.T) {
+	deleting := newVM("c", "large")
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+
+	vms := []v1alpha1.VirtualMachine{*newVM("a", "small"), *newVM("b", "large"), *deleting}
+	classSpecs := map[string]*v1alpha1.VirtualMachineClassSpec{
+		"small": newClassSpec(2, "4Gi"),
+		"large": newClassSpec(8, "32Gi"),
+	}
+
+	usage, err := NamespaceUsage(vms, classSpecs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The VirtualMachine being deleted is not accounted for.
+	assertUsage(t, usage, corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaVirtualMachines: resource.MustParse("2"),
+		v1alpha1.VirtualMachineQuotaCPUs:            resource.MustParse("10"),
+		v1alpha1.VirtualMachineQuotaMemory:          resource.MustParse("36Gi"),
+		v1alpha1.VirtualMachineQuotaLimitsCPU:       resource.MustParse("10"),
+	})
+
+	delete(classSpecs, "large")
+	if _, err := NamespaceUsage(vms, classSpecs); err == nil {
+		t.Errorf("expected an error when a class is not resolved")
+	}
+}
+
+func TestNamespaceUsageSelectedClass(t *testing.T) {
+	selected := newVM("a", "")
+	selected.Spec.Resources = &v1alpha1.VirtualMachineResourceRequirements{Cpus: 2}
+	selected.Status.SelectedClassName = "small"
+
+	pending := newVM("b", "")
+	pending.Spec.Resources = &v1alpha1.VirtualMachineResourceRequirements{
+		Cpus:                       3,
+		Memory:                     resource.MustParse("6Gi"),
+		VGPUDevices:                1,
+		DynamicDirectPathIODevices: 2,
+	}
+
+	vms := []v1alpha1.VirtualMachine{*selected, *pending}
+	classSpecs := map[string]*v1alpha1.VirtualMachineClassSpec{
+		"small": newClassSpec(4, "8Gi"),
+	}
+
+	usage, err := NamespaceUsage(vms, classSpecs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The VirtualMachine with a selected class is accounted for by the class, and the other one by its Resources.
+	assertUsage(t, usage, corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaVirtualMachines:            resource.MustParse("2"),
+		v1alpha1.VirtualMachineQuotaCPUs:                       resource.MustParse("7"),
+		v1alpha1.VirtualMachineQuotaMemory:                     resource.MustParse("14Gi"),
+		v1alpha1.VirtualMachineQuotaLimitsCPU:                  resource.MustParse("4"),
+		v1alpha1.VirtualMachineQuotaVGPUs:                      resource.MustParse("1"),
+		v1alpha1.VirtualMachineQuotaDynamicDirectPathIODevices: resource.MustParse("2"),
+	})
+}
+
+func TestExceeded(t *testing.T) {
+	hard := corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaCPUs:   resource.MustParse("8"),
+		v1alpha1.VirtualMachineQuotaMemory: resource.MustParse("16Gi"),
+	}
+	small := corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaCPUs:   resource.MustParse("2"),
+		v1alpha1.VirtualMachineQuotaMemory: resource.MustParse("4Gi"),
+		v1alpha1.VirtualMachineQuotaVGPUs:  resource.MustParse("1"),
+	}
+	large := corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaCPUs:   resource.MustParse("4"),
+		v1alpha1.VirtualMachineQuotaMemory: resource.MustParse("4Gi"),
+	}
+
+	tests := []struct {
+		name     string
+		used     corev1.ResourceList
+		oldUsage corev1.ResourceList
+		newUsage corev1.ResourceList
+		expected []corev1.ResourceName
+	}{
+		{
+			name:     "create within the limits",
+			used:     corev1.ResourceList{v1alpha1.VirtualMachineQuotaCPUs: resource.MustParse("6")},
+			newUsage: small,
+		},
+		{
+			name: "create beyond the limits",
+			used: corev1.ResourceList{
+				v1alpha1.VirtualMachineQuotaCPUs:   resource.MustParse("7"),
+				v1alpha1.VirtualMachineQuotaMemory: resource.MustParse("14Gi"),
+			},
+			newUsage: small,
+			expected: []corev1.ResourceName{v1alpha1.VirtualMachineQuotaCPUs, v1alpha1.VirtualMachineQuotaMemory},
+		},
+		{
+			// The used resources include the old usage of the VirtualMachine: only the 2 additional CPUs count.
+			name:     "update within the limits",
+			used:     corev1.ResourceList{v1alpha1.VirtualMachineQuotaCPUs: resource.MustParse("6")},
+			oldUsage: small,
+			newUsage: large,
+		},
+		{
+			name:     "update beyond the limits",
+			used:     corev1.ResourceList{v1alpha1.VirtualMachineQuotaCPUs: resource.MustParse("7")},
+			oldUsage: small,
+			newUsage: large,
+			expected: []corev1.ResourceName{v1alpha1.VirtualMachineQuotaCPUs},
+		},
+		{
+			// The memory is already beyond its limit, but the update does not increase it.
+			name:     "pre-existing overage",
+			used:     corev1.ResourceList{v1alpha1.VirtualMachineQuotaMemory: resource.MustParse("20Gi")},
+			oldUsage: small,
+			newUsage: large,
+		},
+		{
+			name:     "shrink",
+			used:     corev1.ResourceList{v1alpha1.VirtualMachineQuotaCPUs: resource.MustParse("12")},
+			oldUsage: large,
+			newUsage: small,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if exceeded := Exceeded(hard, tt.used, tt.oldUsage, tt.newUsage); !reflect.DeepEqual(exceeded, tt.expected) {
+				t.Errorf("Exceeded() = %v, want %v", exceeded, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	hard := corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaCPUs:  resource.MustParse("8"),
+		v1alpha1.VirtualMachineQuotaVGPUs: resource.MustParse("2"),
+	}
+	resources := corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaCPUs:   resource.MustParse("4"),
+		v1alpha1.VirtualMachineQuotaMemory: resource.MustParse("8Gi"),
+	}
+
+	masked := Mask(resources, hard)
+	if len(masked) != 2 {
+		t.Errorf("Mask() = %v, want the cpus and vgpus only", masked)
+	}
+	assertUsage(t, masked, corev1.ResourceList{
+		v1alpha1.VirtualMachineQuotaCPUs:  resource.MustParse("4"),
+		v1alpha1.VirtualMachineQuotaVGPUs: resource.MustParse("0"),
+	})
+}
+
+func newVM(name, className string) *v1alpha1.VirtualMachine {
+	vm := &v1alpha1.VirtualMachine{}
+	vm.Name, vm.Namespace = name, "my-namespace"
+	vm.Spec.ClassName = className
+	return vm
+}
+
+func newClassSpec(cpus int64, memory string) *v1alpha1.VirtualMachineClassSpec {
+	spec := &v1alpha1.VirtualMachineClassSpec{}
+	spec.Hardware.Cpus = cpus
+	spec.Hardware.Memory = resource.MustParse(memory)
+	return spec
+}
+
+// assertUsage asserts that the usage has the expected quantities, ignoring the other resources of the usage.
+func assertUsage(t *testing.T, usage, expected corev1.ResourceList) {
+	t.Helper()
+
+	for name, want := range expected {
+		got, ok := usage[name]
+		if !ok {
+			t.Errorf("%s is missing", name)
+		} else if got.Cmp(want) != 0 {
+			t.Errorf("%s = %s, want %s", name, got.String(), want.String())
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/configspec"
+)
+
+var validConfigSpecDeviceOperations = []string{
+	string(v1alpha1.ConfigSpecDeviceOperationAdd),
+	string(v1alpha1.ConfigSpecDeviceOperationRemove),
+	string(v1alpha1.ConfigSpecDeviceOperationEdit),
+}
+
+var validConfigSpecDeviceFileOperations = []string{
+	string(v1alpha1.ConfigSpecDeviceFileOperationCreate),
+	string(v1alpha1.ConfigSpecDeviceFileOperationDestroy),
+	string(v1alpha1.ConfigSpecDeviceFileOperationReplace),
+}
+
+// ValidateVirtualMachineConfigSpec validates that exactly one of the XML and the Structured forms of a
+// VirtualMachineConfigSpec is specified, and that it is well-formed.
+func ValidateVirtualMachineConfigSpec(vmConfigSpec *v1alpha1.VirtualMachineConfigSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case vmConfigSpec.XML != "" && vmConfigSpec.Structured != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("structured"),
+			"may not be specified when `xml` is specified"))
+	case vmConfigSpec.XML != "":
+		if _, _, err := configspec.Decode(vmConfigSpec.XML); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("xml"), "", err.Error()))
+		}
+	case vmConfigSpec.Structured != nil:
+		allErrs = append(allErrs, validateStructuredConfigSpec(vmConfigSpec.Structured, fldPath.Child("structured"))...)
+	default:
+		allErrs = append(allErrs, field.Required(fldPath, "one of `xml` and `structured` must be specified"))
+	}
+
+	return allErrs
+}
+
+func validateStructuredConfigSpec(structured *v1alpha1.StructuredConfigSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if structured.NumCPUs < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("numCPUs"), structured.NumCPUs,
+			"must be greater than or equal to 0"))
+	}
+	if cps := structured.NumCoresPerSocket; cps < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("numCoresPerSocket"), cps,
+			"must be greater than or equal to 0"))
+	} else if cps > 0 && structured.NumCPUs > 0 && structured.NumCPUs%cps != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("numCoresPerSocket"), cps,
+			fmt.Sprintf("must evenly divide the %d numCPUs", structured.NumCPUs)))
+	}
+	if structured.MemoryMB < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryMB"), structured.MemoryMB,
+			"must be greater than or equal to 0"))
+	}
+
+	switch structured.HyperthreadSharing {
+	case "", v1alpha1.HyperthreadSharingAny, v1alpha1.HyperthreadSharingNone, v1alpha1.HyperthreadSharingInternal:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("hyperthreadSharing"),
+			structured.HyperthreadSharing, validHyperthreadSharings))
+	}
+
+	switch structured.LatencySensitivity {
+	case "", v1alpha1.LatencySensitivityLow, v1alpha1.LatencySensitivityNormal, v1alpha1.LatencySensitivityMedium,
+		v1alpha1.LatencySensitivityHigh:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("latencySensitivity"),
+			structured.LatencySensitivity, validLatencySensitivities))
+	}
+
+	switch structured.Firmware {
+	case "", v1alpha1.BIOSFirmware, v1alpha1.EFIFirmware:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("firmware"), structured.Firmware, validFirmwares))
+	}
+
+	if structured.CpuShares != nil {
+		allErrs = append(allErrs, validateShares(structured.CpuShares, fldPath.Child("cpuShares"))...)
+	}
+	if structured.MemoryShares != nil {
+		allErrs = append(allErrs, validateShares(structured.MemoryShares, fldPath.Child("memoryShares"))...)
+	}
+
+	for i := range structured.DeviceChanges {
+		dc := &structured.DeviceChanges[i]
+		dcPath := fldPath.Child("deviceChanges").Index(i)
+
+		switch dc.Operation {
+		case v1alpha1.ConfigSpecDeviceOperationAdd, v1alpha1.ConfigSpecDeviceOperationRemove,
+			v1alpha1.ConfigSpecDeviceOperationEdit:
+		case "":
+			allErrs = append(allErrs, field.Required(dcPath.Child("operation"), ""))
+		default:
+			allErrs = append(allErrs, field.NotSupported(dcPath.Child("operation"), dc.Operation,
+				validConfigSpecDeviceOperations))
+		}
+
+		switch dc.FileOperation {
+		case "", v1alpha1.ConfigSpecDeviceFileOperationCreate, v1alpha1.ConfigSpecDeviceFileOperationDestroy,
+			v1alpha1.ConfigSpecDeviceFileOperationReplace:
+		default:
+			allErrs = append(allErrs, field.NotSupported(dcPath.Child("fileOperation"), dc.FileOperation,
+				validConfigSpecDeviceFileOperations))
+		}
+
+		allErrs = append(allErrs, validateConfigSpecDevice(&dc.Device, dcPath.Child("device"))...)
+	}
+
+	for k := range structured.ExtraConfig {
+		if k == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("extraConfig"), k, "key must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateConfigSpecDevice(device *v1alpha1.ConfigSpecDevice, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var numKinds int
+	if device.NIC != nil {
+		numKinds++
+	}
+	if device.Disk != nil {
+		numKinds++
+		if device.Disk.Capacity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("disk", "capacity"), device.Disk.Capacity.String(),
+				"must be greater than or equal to 0"))
+		}
+	}
+	if device.VGPU != nil {
+		numKinds++
+		if device.VGPU.ProfileName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("vgpu", "profileName"), ""))
+		}
+	}
+	if device.DynamicDirectPathIO != nil {
+		numKinds++
+		allowedPath := fldPath.Child("dynamicDirectPathIO", "allowedDevices")
+		if len(device.DynamicDirectPathIO.AllowedDevices) == 0 {
+			allErrs = append(allErrs, field.Required(allowedPath, ""))
+		}
+		for j, d := range device.DynamicDirectPathIO.AllowedDevices {
+			if d.VendorID <= 0 {
+				allErrs = append(allErrs, field.Invalid(allowedPath.Index(j).Child("vendorID"), d.VendorID,
+					"must be greater than 0"))
+			}
+			if d.DeviceID <= 0 {
+				allErrs = append(allErrs, field.Invalid(allowedPath.Index(j).Child("deviceID"), d.DeviceID,
+					"must be greater than 0"))
+			}
+		}
+	}
+
+	if numKinds != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, "",
+			"exactly one of `nic`, `disk`, `vgpu` and `dynamicDirectPathIO` must be specified"))
+	}
+
+	return allErrs
+}
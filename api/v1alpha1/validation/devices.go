@@ -0,0 +1,92 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// validateVirtualDevices validates the syntax of a set of vGPU and Dynamic DirectPath I/O devices.
+func validateVirtualDevices(devices *v1alpha1.VirtualDevices, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, d := range devices.VGPUDevices {
+		if d.ProfileName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("vgpuDevices").Index(i).Child("profileName"), ""))
+		}
+	}
+
+	for i, d := range devices.DynamicDirectPathIODevices {
+		p := fldPath.Child("dynamicDirectPathIODevices").Index(i)
+		if d.VendorID <= 0 {
+			allErrs = append(allErrs, field.Invalid(p.Child("vendorID"), d.VendorID, "must be greater than 0"))
+		}
+		if d.DeviceID <= 0 {
+			allErrs = append(allErrs, field.Invalid(p.Child("deviceID"), d.DeviceID, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineAdditionalDevices validates that the AdditionalDevices of a VirtualMachine are allowed by the
+// devices policy of the VirtualMachineClass it references.
+func ValidateVirtualMachineAdditionalDevices(
+	vm *v1alpha1.VirtualMachine,
+	vmClass *v1alpha1.VirtualMachineClass) field.ErrorList {
+
+	devices := vm.Spec.AdditionalDevices
+	if devices == nil || len(devices.VGPUDevices)+len(devices.DynamicDirectPathIODevices) == 0 {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("spec", "additionalDevices")
+
+	policy := vmClass.Spec.Policies.Devices
+	if policy == nil {
+		return append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("VirtualMachineClass %s does not allow additional devices", vmClass.Name)))
+	}
+
+	if n := len(devices.VGPUDevices); n > int(policy.MaxVGPUDevices) {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("vgpuDevices"), n, int(policy.MaxVGPUDevices)))
+	}
+	for i, d := range devices.VGPUDevices {
+		if !containsString(policy.AllowedVGPUProfileNames, d.ProfileName) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("vgpuDevices").Index(i).Child("profileName"),
+				d.ProfileName, policy.AllowedVGPUProfileNames))
+		}
+	}
+
+	if n := len(devices.DynamicDirectPathIODevices); n > int(policy.MaxDynamicDirectPathIODevices) {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("dynamicDirectPathIODevices"), n,
+			int(policy.MaxDynamicDirectPathIODevices)))
+	}
+	for i, d := range devices.DynamicDirectPathIODevices {
+		if !isDynamicDirectPathIODeviceAllowed(policy.AllowedDynamicDirectPathIODevices, d) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("dynamicDirectPathIODevices").Index(i),
+				fmt.Sprintf("device with vendorID %d and deviceID %d is not allowed by VirtualMachineClass %s",
+					d.VendorID, d.DeviceID, vmClass.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+func isDynamicDirectPathIODeviceAllowed(
+	allowed []v1alpha1.DynamicDirectPathIODevice,
+	device v1alpha1.DynamicDirectPathIODevice) bool {
+
+	for _, a := range allowed {
+		if a.VendorID == device.VendorID && a.DeviceID == device.DeviceID {
+			return true
+		}
+	}
+	return false
+}
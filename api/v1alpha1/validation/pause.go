@@ -0,0 +1,43 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// ValidatePause validates a PauseSpec.
+func ValidatePause(pause *v1alpha1.PauseSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if pause.Reason == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("reason"), ""))
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineService validates the spec of a VirtualMachineService.
+func ValidateVirtualMachineService(s *v1alpha1.VirtualMachineService) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if s.Spec.Pause != nil {
+		allErrs = append(allErrs, ValidatePause(s.Spec.Pause, field.NewPath("spec", "pause"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineSetResourcePolicy validates the spec of a VirtualMachineSetResourcePolicy.
+func ValidateVirtualMachineSetResourcePolicy(res *v1alpha1.VirtualMachineSetResourcePolicy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if res.Spec.Pause != nil {
+		allErrs = append(allErrs, ValidatePause(res.Spec.Pause, field.NewPath("spec", "pause"))...)
+	}
+
+	return allErrs
+}
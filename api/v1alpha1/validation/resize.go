@@ -0,0 +1,81 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// ValidateVirtualMachineResize validates a change of the EffectiveClassName of a VirtualMachine from oldClass to
+// newClass.  A powered off VirtualMachine may change to any VirtualMachineClass.  A powered on VirtualMachine may
+// only be resized in place: newClass may only add CPUs and memory, the hardware of oldClass, with which the
+// VirtualMachine was powered on, must have hot-add enabled for them, and all other hardware must be unchanged.
+// Since the class selected for the Resources of a VirtualMachine is only known once it has been selected, the
+// Resources of a powered on VirtualMachine may not be changed; it is resized by specifying a ClassName instead.
+func ValidateVirtualMachineResize(
+	vm, oldVM *v1alpha1.VirtualMachine,
+	newClass, oldClass *v1alpha1.VirtualMachineClass) field.ErrorList {
+
+	if oldVM.Status.PowerState != v1alpha1.VirtualMachinePoweredOn {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if vm.Spec.Resources != nil && !apiequality.Semantic.DeepEqual(vm.Spec.Resources, oldVM.Spec.Resources) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "resources"),
+			"cannot be changed while the VirtualMachine is powered on"))
+	}
+
+	if vm.EffectiveClassName() == oldVM.EffectiveClassName() {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "className")
+	oldHW, newHW := oldClass.Spec.Hardware, newClass.Spec.Hardware
+
+	switch {
+	case newHW.Cpus < oldHW.Cpus:
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("cannot reduce CPUs of a powered on VirtualMachine from %d to %d", oldHW.Cpus, newHW.Cpus)))
+	case newHW.Cpus > oldHW.Cpus && !oldHW.CpuHotAddEnabled:
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("CPU hot-add is not enabled by VirtualMachineClass %s", oldClass.Name)))
+	}
+
+	switch c := newHW.Memory.Cmp(oldHW.Memory); {
+	case c < 0:
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("cannot reduce memory of a powered on VirtualMachine from %s to %s",
+				oldHW.Memory.String(), newHW.Memory.String())))
+	case c > 0 && !oldHW.MemoryHotAddEnabled:
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("memory hot-add is not enabled by VirtualMachineClass %s", oldClass.Name)))
+	}
+
+	// Only the CPUs and the memory may be hot-added, so the rest of the hardware, such as the devices, the instance
+	// storage and the CPU topology, must be unchanged.
+	if !apiequality.Semantic.DeepEqual(hardwareExceptHotAdd(newHW), hardwareExceptHotAdd(oldHW)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"cannot change hardware other than the CPUs and memory of a powered on VirtualMachine"))
+	}
+
+	return allErrs
+}
+
+// hardwareExceptHotAdd returns the hardware without the CPUs and memory, and without the settings that enable them
+// to be hot-added.
+func hardwareExceptHotAdd(hw v1alpha1.VirtualMachineClassHardware) v1alpha1.VirtualMachineClassHardware {
+	hw.Cpus = 0
+	hw.Memory = resource.Quantity{}
+	hw.CpuHotAddEnabled = false
+	hw.MemoryHotAddEnabled = false
+	return hw
+}
@@ -0,0 +1,378 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation provides validation functions for the VM Operator v1alpha1 API types that cannot be expressed
+// as OpenAPI schema markers, such as checks across multiple fields.
+package validation
+
+import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+var validTopologyKeys = []string{
+	v1alpha1.VirtualMachineAffinityTopologyKeyHost,
+	v1alpha1.VirtualMachineAffinityTopologyKeyZone,
+}
+
+var validRestartPolicies = []string{
+	string(v1alpha1.VirtualMachineRestartPolicyAlways),
+	string(v1alpha1.VirtualMachineRestartPolicyOnFailure),
+	string(v1alpha1.VirtualMachineRestartPolicyNever),
+}
+
+var validFirmwares = []string{
+	string(v1alpha1.BIOSFirmware),
+	string(v1alpha1.EFIFirmware),
+}
+
+var validUnsatisfiableConstraintActions = []string{
+	string(v1alpha1.DoNotSchedule),
+	string(v1alpha1.ScheduleAnyway),
+}
+
+// ValidateVirtualMachine validates the spec of a VirtualMachine.
+func ValidateVirtualMachine(vm *v1alpha1.VirtualMachine) field.ErrorList {
+	return ValidateVirtualMachineSpec(&vm.Spec, field.NewPath("spec"))
+}
+
+// ValidateVirtualMachineSpec validates a VirtualMachineSpec, either of a VirtualMachine or of a template used
+// to create VirtualMachines.
+func ValidateVirtualMachineSpec(spec *v1alpha1.VirtualMachineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Affinity != nil {
+		allErrs = append(allErrs, ValidateVirtualMachineAffinity(spec.Affinity, fldPath.Child("affinity"))...)
+	}
+
+	if spec.Topology != nil {
+		allErrs = append(allErrs, ValidateVirtualMachineTopology(spec.Topology, fldPath.Child("topology"))...)
+	}
+
+	if spec.ReadinessProbe != nil {
+		allErrs = append(allErrs, validateProbe(spec.ReadinessProbe, fldPath.Child("readinessProbe"))...)
+		if spec.ReadinessProbe.FailureThreshold != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("readinessProbe", "failureThreshold"),
+				"may only be specified for a livenessProbe"))
+		}
+	}
+
+	if spec.LivenessProbe != nil {
+		allErrs = append(allErrs, validateProbe(spec.LivenessProbe, fldPath.Child("livenessProbe"))...)
+	}
+
+	allErrs = append(allErrs, validateRestartPolicy(spec, fldPath)...)
+
+	if spec.Pause != nil {
+		allErrs = append(allErrs, ValidatePause(spec.Pause, fldPath.Child("pause"))...)
+	}
+
+	if spec.AdditionalDevices != nil {
+		allErrs = append(allErrs, validateVirtualDevices(spec.AdditionalDevices, fldPath.Child("additionalDevices"))...)
+	}
+
+	allErrs = append(allErrs, validateCrypto(spec, fldPath)...)
+	allErrs = append(allErrs, validateResourceRequirements(spec, fldPath)...)
+
+	return allErrs
+}
+
+// ValidateVirtualMachineAffinity validates the affinity and anti-affinity rules of a VirtualMachine.
+func ValidateVirtualMachineAffinity(affinity *v1alpha1.VirtualMachineAffinitySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if a := affinity.VirtualMachineAffinity; a != nil {
+		p := fldPath.Child("vmAffinity")
+		allErrs = append(allErrs, validateAffinityTerms(a.RequiredDuringSchedulingIgnoredDuringExecution,
+			p.Child("requiredDuringSchedulingIgnoredDuringExecution"))...)
+		allErrs = append(allErrs, validateWeightedAffinityTerms(a.PreferredDuringSchedulingIgnoredDuringExecution,
+			p.Child("preferredDuringSchedulingIgnoredDuringExecution"))...)
+	}
+
+	if a := affinity.VirtualMachineAntiAffinity; a != nil {
+		p := fldPath.Child("vmAntiAffinity")
+		allErrs = append(allErrs, validateAffinityTerms(a.RequiredDuringSchedulingIgnoredDuringExecution,
+			p.Child("requiredDuringSchedulingIgnoredDuringExecution"))...)
+		allErrs = append(allErrs, validateWeightedAffinityTerms(a.PreferredDuringSchedulingIgnoredDuringExecution,
+			p.Child("preferredDuringSchedulingIgnoredDuringExecution"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineTopology validates the availability zone placement constraints of a VirtualMachine.
+func ValidateVirtualMachineTopology(topology *v1alpha1.VirtualMachineTopologySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if topology.Zone != "" {
+		if len(topology.AllowedZones) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("allowedZones"),
+				"may not be specified when zone is specified"))
+		}
+		for _, msg := range validation.IsDNS1123Subdomain(topology.Zone) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zone"), topology.Zone, msg))
+		}
+	}
+
+	zones := sets.NewString()
+	for i, zone := range topology.AllowedZones {
+		p := fldPath.Child("allowedZones").Index(i)
+		for _, msg := range validation.IsDNS1123Subdomain(zone) {
+			allErrs = append(allErrs, field.Invalid(p, zone, msg))
+		}
+		if zones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(p, zone))
+		}
+		zones.Insert(zone)
+	}
+
+	type spreadKey struct {
+		topologyKey       string
+		whenUnsatisfiable v1alpha1.UnsatisfiableConstraintAction
+	}
+	existing := map[spreadKey]struct{}{}
+
+	for i := range topology.SpreadConstraints {
+		c := &topology.SpreadConstraints[i]
+		p := fldPath.Child("spreadConstraints").Index(i)
+
+		if c.MaxSkew < 1 {
+			allErrs = append(allErrs, field.Invalid(p.Child("maxSkew"), c.MaxSkew, "must be greater than zero"))
+		}
+		allErrs = append(allErrs, validateTopologyKey(c.TopologyKey, p.Child("topologyKey"))...)
+
+		switch c.WhenUnsatisfiable {
+		case "":
+			allErrs = append(allErrs, field.Required(p.Child("whenUnsatisfiable"), ""))
+		case v1alpha1.DoNotSchedule, v1alpha1.ScheduleAnyway:
+		default:
+			allErrs = append(allErrs, field.NotSupported(p.Child("whenUnsatisfiable"), c.WhenUnsatisfiable,
+				validUnsatisfiableConstraintActions))
+		}
+
+		if c.LabelSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(c.LabelSelector, p.Child("labelSelector"))...)
+		}
+
+		key := spreadKey{topologyKey: c.TopologyKey, whenUnsatisfiable: c.WhenUnsatisfiable}
+		if _, ok := existing[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(p, "{topologyKey, whenUnsatisfiable}"))
+		}
+		existing[key] = struct{}{}
+	}
+
+	return allErrs
+}
+
+func validateProbe(probe *v1alpha1.Probe, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	numActions := 0
+	if probe.TCPSocket != nil {
+		numActions++
+		allErrs = append(allErrs, validateTCPSocketAction(probe.TCPSocket, fldPath.Child("tcpSocket"))...)
+	}
+	if probe.GuestHeartbeat != nil {
+		if numActions > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("guestHeartbeat"),
+				"may not specify more than 1 probe action"))
+		}
+		numActions++
+	}
+	if numActions == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify a probe action"))
+	}
+
+	if probe.TimeoutSeconds < 0 || probe.TimeoutSeconds > 60 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSeconds"), probe.TimeoutSeconds,
+			"must be in the range 1-60"))
+	}
+	if probe.PeriodSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("periodSeconds"), probe.PeriodSeconds,
+			"must be greater than or equal to 1"))
+	}
+	if probe.FailureThreshold < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), probe.FailureThreshold,
+			"must be greater than or equal to 1"))
+	}
+
+	return allErrs
+}
+
+func validateTCPSocketAction(action *v1alpha1.TCPSocketAction, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch action.Port.Type {
+	case intstr.Int:
+		for _, msg := range validation.IsValidPortNum(action.Port.IntValue()) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), action.Port.IntVal, msg))
+		}
+	case intstr.String:
+		for _, msg := range validation.IsValidPortName(action.Port.StrVal) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), action.Port.StrVal, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateRestartPolicy(spec *v1alpha1.VirtualMachineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch spec.RestartPolicy {
+	case "", v1alpha1.VirtualMachineRestartPolicyNever:
+		if spec.RestartBackoff != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("restartBackoff"),
+				"may not be specified when `restartPolicy` is 'Never'"))
+		}
+	case v1alpha1.VirtualMachineRestartPolicyOnFailure:
+		if spec.LivenessProbe == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("livenessProbe"),
+				"must be specified when `restartPolicy` is 'OnFailure'"))
+		}
+	case v1alpha1.VirtualMachineRestartPolicyAlways:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("restartPolicy"), spec.RestartPolicy,
+			validRestartPolicies))
+	}
+
+	if b := spec.RestartBackoff; b != nil {
+		p := fldPath.Child("restartBackoff")
+		if b.InitialDelaySeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(p.Child("initialDelaySeconds"), b.InitialDelaySeconds,
+				"must be greater than or equal to 0"))
+		}
+		if b.MaxDelaySeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(p.Child("maxDelaySeconds"), b.MaxDelaySeconds,
+				"must be greater than or equal to 1"))
+		} else if b.MaxDelaySeconds != 0 && b.MaxDelaySeconds < b.InitialDelaySeconds {
+			allErrs = append(allErrs, field.Invalid(p.Child("maxDelaySeconds"), b.MaxDelaySeconds,
+				"must be greater than or equal to `initialDelaySeconds`"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCrypto(spec *v1alpha1.VirtualMachineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if c := spec.Crypto; c != nil && c.EncryptionClassName != "" && c.KeyProviderID != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("crypto", "keyProviderID"),
+			"may not be specified when `encryptionClassName` is specified"))
+	}
+
+	opts := spec.AdvancedOptions
+	if opts == nil {
+		return allErrs
+	}
+	optsPath := fldPath.Child("advancedOptions")
+
+	switch opts.Firmware {
+	case "", v1alpha1.BIOSFirmware, v1alpha1.EFIFirmware:
+	default:
+		allErrs = append(allErrs, field.NotSupported(optsPath.Child("firmware"), opts.Firmware, validFirmwares))
+	}
+
+	if opts.VirtualTPM != nil && *opts.VirtualTPM && opts.Firmware != v1alpha1.EFIFirmware {
+		allErrs = append(allErrs, field.Invalid(optsPath.Child("virtualTPM"), *opts.VirtualTPM,
+			"a vTPM requires `firmware` to be explicitly set to 'efi'"))
+	}
+
+	return allErrs
+}
+
+func validateResourceRequirements(spec *v1alpha1.VirtualMachineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	res := spec.Resources
+	switch {
+	case spec.ClassName != "" && res != nil:
+		return append(allErrs, field.Forbidden(fldPath.Child("resources"),
+			"may not be specified when `className` is specified"))
+	case spec.ClassName == "" && res == nil:
+		return append(allErrs, field.Required(fldPath.Child("className"),
+			"one of `className` and `resources` must be specified"))
+	case res == nil:
+		return allErrs
+	}
+
+	resPath := fldPath.Child("resources")
+	if res.Cpus < 0 {
+		allErrs = append(allErrs, field.Invalid(resPath.Child("cpus"), res.Cpus, "must be greater than or equal to 0"))
+	}
+	allErrs = append(allErrs, validateNonNegativeQuantity(res.Memory, resPath.Child("memory"))...)
+	if res.VGPUDevices < 0 {
+		allErrs = append(allErrs, field.Invalid(resPath.Child("vgpuDevices"), res.VGPUDevices,
+			"must be greater than or equal to 0"))
+	}
+	if res.DynamicDirectPathIODevices < 0 {
+		allErrs = append(allErrs, field.Invalid(resPath.Child("dynamicDirectPathIODevices"),
+			res.DynamicDirectPathIODevices, "must be greater than or equal to 0"))
+	}
+	if len(res.VGPUProfileNames) > 0 && res.VGPUDevices == 0 {
+		allErrs = append(allErrs, field.Forbidden(resPath.Child("vgpuProfileNames"),
+			"may only be specified when `vgpuDevices` is greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateTopologyKey(topologyKey string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch topologyKey {
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath, ""))
+	case v1alpha1.VirtualMachineAffinityTopologyKeyHost, v1alpha1.VirtualMachineAffinityTopologyKeyZone:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, topologyKey, validTopologyKeys))
+	}
+
+	return allErrs
+}
+
+func validateAffinityTerms(terms []v1alpha1.VirtualMachineAffinityTerm, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i := range terms {
+		allErrs = append(allErrs, validateAffinityTerm(&terms[i], fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateWeightedAffinityTerms(terms []v1alpha1.WeightedVirtualMachineAffinityTerm, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i := range terms {
+		p := fldPath.Index(i)
+		if w := terms[i].Weight; w < 1 || w > 100 {
+			allErrs = append(allErrs, field.Invalid(p.Child("weight"), w, "must be in the range 1-100"))
+		}
+		allErrs = append(allErrs, validateAffinityTerm(&terms[i].VirtualMachineAffinityTerm,
+			p.Child("virtualMachineAffinityTerm"))...)
+	}
+
+	return allErrs
+}
+
+func validateAffinityTerm(term *v1alpha1.VirtualMachineAffinityTerm, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if term.LabelSelector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("labelSelector"), ""))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(term.LabelSelector,
+			fldPath.Child("labelSelector"))...)
+	}
+
+	allErrs = append(allErrs, validateTopologyKey(term.TopologyKey, fldPath.Child("topologyKey"))...)
+
+	return allErrs
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+var validPowerStates = []string{
+	string(v1alpha1.VirtualMachinePoweredOff),
+	string(v1alpha1.VirtualMachinePoweredOn),
+}
+
+// ValidateVirtualMachineBackup validates the spec of a VirtualMachineBackup.
+func ValidateVirtualMachineBackup(backup *v1alpha1.VirtualMachineBackup) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if backup.Spec.VirtualMachineName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("virtualMachineName"), ""))
+	}
+	if backup.Spec.BaseBackupName != "" && backup.Spec.BaseBackupName == backup.Name {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("baseBackupName"), backup.Spec.BaseBackupName,
+			"may not refer to the backup itself"))
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineBackupUpdate validates an update to a VirtualMachineBackup.  The spec of a
+// VirtualMachineBackup is immutable.
+func ValidateVirtualMachineBackupUpdate(backup, oldBackup *v1alpha1.VirtualMachineBackup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if backup.Spec != oldBackup.Spec {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineBackupSource validates that the VirtualMachine referenced by a VirtualMachineBackup is in a
+// state that allows it to be backed up as requested.  The base backup, if any, must be passed for incremental
+// backups.
+func ValidateVirtualMachineBackupSource(
+	backup *v1alpha1.VirtualMachineBackup,
+	vm *v1alpha1.VirtualMachine,
+	baseBackup *v1alpha1.VirtualMachineBackup) field.ErrorList {
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if vm.Status.Phase != v1alpha1.Created {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("virtualMachineName"), vm.Name,
+			"VirtualMachine has not been created yet"))
+	}
+
+	if backup.Spec.BaseBackupName == "" {
+		return allErrs
+	}
+
+	basePath := specPath.Child("baseBackupName")
+	if vm.Status.ChangeBlockTracking == nil || !*vm.Status.ChangeBlockTracking {
+		allErrs = append(allErrs, field.Invalid(basePath, backup.Spec.BaseBackupName,
+			"incremental backups require ChangeBlockTracking to be enabled on the VirtualMachine"))
+	}
+	switch {
+	case baseBackup == nil:
+		allErrs = append(allErrs, field.NotFound(basePath, backup.Spec.BaseBackupName))
+	case baseBackup.Spec.VirtualMachineName != backup.Spec.VirtualMachineName:
+		allErrs = append(allErrs, field.Invalid(basePath, backup.Spec.BaseBackupName,
+			"base backup is of a different VirtualMachine"))
+	case baseBackup.Status.Phase != v1alpha1.BackupCompleted:
+		allErrs = append(allErrs, field.Invalid(basePath, backup.Spec.BaseBackupName,
+			"base backup has not completed"))
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineRestore validates the spec of a VirtualMachineRestore.
+func ValidateVirtualMachineRestore(restore *v1alpha1.VirtualMachineRestore) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	spec := &restore.Spec
+
+	if spec.BackupName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("backupName"), ""))
+	}
+	if spec.TargetNamespace != "" {
+		for _, msg := range validation.IsDNS1123Label(spec.TargetNamespace) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("targetNamespace"), spec.TargetNamespace, msg))
+		}
+	}
+	if spec.VirtualMachineName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(spec.VirtualMachineName) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("virtualMachineName"), spec.VirtualMachineName, msg))
+		}
+	}
+
+	switch spec.PowerState {
+	case "", v1alpha1.VirtualMachinePoweredOff, v1alpha1.VirtualMachinePoweredOn:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("powerState"), spec.PowerState, validPowerStates))
+	}
+
+	volumeNames, claimNames := sets.NewString(), sets.NewString()
+	for i, m := range spec.VolumeMappings {
+		p := specPath.Child("volumeMappings").Index(i)
+		if m.VolumeName == "" {
+			allErrs = append(allErrs, field.Required(p.Child("volumeName"), ""))
+		} else if volumeNames.Has(m.VolumeName) {
+			allErrs = append(allErrs, field.Duplicate(p.Child("volumeName"), m.VolumeName))
+		}
+		volumeNames.Insert(m.VolumeName)
+
+		if m.ClaimName == "" {
+			allErrs = append(allErrs, field.Required(p.Child("claimName"), ""))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(m.ClaimName) {
+				allErrs = append(allErrs, field.Invalid(p.Child("claimName"), m.ClaimName, msg))
+			}
+			if claimNames.Has(m.ClaimName) {
+				allErrs = append(allErrs, field.Duplicate(p.Child("claimName"), m.ClaimName))
+			}
+		}
+		claimNames.Insert(m.ClaimName)
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineRestoreUpdate validates an update to a VirtualMachineRestore.  The spec of a
+// VirtualMachineRestore is immutable.
+func ValidateVirtualMachineRestoreUpdate(restore, oldRestore *v1alpha1.VirtualMachineRestore) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if restore.Spec.BackupName != oldRestore.Spec.BackupName ||
+		restore.Spec.TargetNamespace != oldRestore.Spec.TargetNamespace ||
+		restore.Spec.VirtualMachineName != oldRestore.Spec.VirtualMachineName ||
+		restore.Spec.StorageClass != oldRestore.Spec.StorageClass ||
+		restore.Spec.PowerState != oldRestore.Spec.PowerState ||
+		!volumeMappingsEqual(restore.Spec.VolumeMappings, oldRestore.Spec.VolumeMappings) {
+		allErrs = append(allErrs, field.Forbidden(specPath, "field is immutable"))
+	}
+
+	return allErrs
+}
+
+func volumeMappingsEqual(a, b []v1alpha1.VirtualMachineRestoreVolumeMapping) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+var validHyperthreadSharings = []string{
+	string(v1alpha1.HyperthreadSharingAny),
+	string(v1alpha1.HyperthreadSharingNone),
+	string(v1alpha1.HyperthreadSharingInternal),
+}
+
+var validLatencySensitivities = []string{
+	string(v1alpha1.LatencySensitivityLow),
+	string(v1alpha1.LatencySensitivityNormal),
+	string(v1alpha1.LatencySensitivityMedium),
+	string(v1alpha1.LatencySensitivityHigh),
+}
+
+var validSharesLevels = []string{
+	string(v1alpha1.SharesLevelLow),
+	string(v1alpha1.SharesLevelNormal),
+	string(v1alpha1.SharesLevelHigh),
+	string(v1alpha1.SharesLevelCustom),
+}
+
+// ValidateVirtualMachineClass validates the spec of a VirtualMachineClass.
+func ValidateVirtualMachineClass(vmClass *v1alpha1.VirtualMachineClass) field.ErrorList {
+	return ValidateVirtualMachineClassSpec(&vmClass.Spec, field.NewPath("spec"))
+}
+
+// ValidateVirtualMachineClassSpec validates a VirtualMachineClassSpec.
+func ValidateVirtualMachineClassSpec(spec *v1alpha1.VirtualMachineClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateClassHardware(&spec.Hardware, fldPath.Child("hardware"))...)
+	allErrs = append(allErrs, validateClassPolicies(spec, fldPath.Child("policies"))...)
+
+	if spec.ConfigSpec != nil {
+		allErrs = append(allErrs, ValidateVirtualMachineConfigSpec(spec.ConfigSpec, fldPath.Child("configSpec"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineClassUpdate validates an update to a VirtualMachineClass.  The spec of a VirtualMachineClass
+// is immutable.
+func ValidateVirtualMachineClassUpdate(vmClass, oldVMClass *v1alpha1.VirtualMachineClass) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !apiequality.Semantic.DeepEqual(vmClass.Spec, oldVMClass.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+// ValidateNamespacedVirtualMachineClass validates the spec of a NamespacedVirtualMachineClass.
+func ValidateNamespacedVirtualMachineClass(vmClass *v1alpha1.NamespacedVirtualMachineClass) field.ErrorList {
+	return ValidateVirtualMachineClassSpec(&vmClass.Spec, field.NewPath("spec"))
+}
+
+// ValidateNamespacedVirtualMachineClassUpdate validates an update to a NamespacedVirtualMachineClass.  Like the spec
+// of a VirtualMachineClass, the spec of a NamespacedVirtualMachineClass is immutable.
+func ValidateNamespacedVirtualMachineClassUpdate(vmClass, oldVMClass *v1alpha1.NamespacedVirtualMachineClass) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !apiequality.Semantic.DeepEqual(vmClass.Spec, oldVMClass.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+func validateClassHardware(hw *v1alpha1.VirtualMachineClassHardware, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if hw.Cpus <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cpus"), hw.Cpus, "must be greater than 0"))
+	}
+	if hw.Memory.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memory"), hw.Memory.String(), "must be greater than 0"))
+	}
+
+	devicesPath := fldPath.Child("devices")
+	allErrs = append(allErrs, validateVirtualDevices(&hw.Devices, devicesPath)...)
+
+	profileNames := sets.NewString()
+	for i, d := range hw.Devices.VGPUDevices {
+		if profileNames.Has(d.ProfileName) {
+			allErrs = append(allErrs, field.Duplicate(devicesPath.Child("vgpuDevices").Index(i).Child("profileName"),
+				d.ProfileName))
+		}
+		profileNames.Insert(d.ProfileName)
+	}
+
+	isPath := fldPath.Child("instanceStorage")
+	if len(hw.InstanceStorage.Volumes) > 0 {
+		if hw.InstanceStorage.StorageClass == "" {
+			allErrs = append(allErrs, field.Required(isPath.Child("storageClass"), ""))
+		}
+		if len(hw.Devices.VGPUDevices) > 0 {
+			allErrs = append(allErrs, field.Forbidden(isPath.Child("volumes"),
+				"instance storage is not supported with vGPU devices"))
+		}
+	}
+	for i, v := range hw.InstanceStorage.Volumes {
+		if v.Size.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(isPath.Child("volumes").Index(i).Child("size"), v.Size.String(),
+				"must be greater than 0"))
+		}
+	}
+
+	allErrs = append(allErrs, validateCPUTopology(hw, fldPath)...)
+
+	return allErrs
+}
+
+func validateCPUTopology(hw *v1alpha1.VirtualMachineClassHardware, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if cps := hw.CoresPerSocket; cps < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("coresPerSocket"), cps,
+			"must be greater than or equal to 0"))
+	} else if cps > 0 && hw.Cpus > 0 && hw.Cpus%int64(cps) != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("coresPerSocket"), cps,
+			fmt.Sprintf("must evenly divide the %d cpus", hw.Cpus)))
+	}
+
+	if numa := hw.NUMA; numa != nil {
+		numaPath := fldPath.Child("numa")
+
+		if cpn := numa.CoresPerNUMANode; cpn < 0 {
+			allErrs = append(allErrs, field.Invalid(numaPath.Child("coresPerNUMANode"), cpn,
+				"must be greater than or equal to 0"))
+		} else if cpn > 0 && hw.Cpus > 0 && hw.Cpus%int64(cpn) != 0 {
+			allErrs = append(allErrs, field.Invalid(numaPath.Child("coresPerNUMANode"), cpn,
+				fmt.Sprintf("must evenly divide the %d cpus", hw.Cpus)))
+		}
+
+		nodes := sets.NewInt32()
+		for i, n := range numa.NodeAffinity {
+			p := numaPath.Child("nodeAffinity").Index(i)
+			if n < 0 {
+				allErrs = append(allErrs, field.Invalid(p, n, "must be greater than or equal to 0"))
+			} else if nodes.Has(n) {
+				allErrs = append(allErrs, field.Duplicate(p, n))
+			}
+			nodes.Insert(n)
+		}
+	}
+
+	switch hw.HyperthreadSharing {
+	case "", v1alpha1.HyperthreadSharingAny, v1alpha1.HyperthreadSharingNone, v1alpha1.HyperthreadSharingInternal:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("hyperthreadSharing"), hw.HyperthreadSharing,
+			validHyperthreadSharings))
+	}
+
+	switch hw.LatencySensitivity {
+	case "", v1alpha1.LatencySensitivityLow, v1alpha1.LatencySensitivityNormal, v1alpha1.LatencySensitivityMedium,
+		v1alpha1.LatencySensitivityHigh:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("latencySensitivity"), hw.LatencySensitivity,
+			validLatencySensitivities))
+	}
+
+	if hw.CpuShares != nil {
+		allErrs = append(allErrs, validateShares(hw.CpuShares, fldPath.Child("cpuShares"))...)
+	}
+	if hw.MemoryShares != nil {
+		allErrs = append(allErrs, validateShares(hw.MemoryShares, fldPath.Child("memoryShares"))...)
+	}
+
+	return allErrs
+}
+
+func validateShares(shares *v1alpha1.VirtualMachineSharesSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch shares.Level {
+	case v1alpha1.SharesLevelCustom:
+		if shares.Shares <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("shares"), shares.Shares,
+				"must be greater than 0 when `level` is 'custom'"))
+		}
+	case v1alpha1.SharesLevelLow, v1alpha1.SharesLevelNormal, v1alpha1.SharesLevelHigh:
+		if shares.Shares != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("shares"),
+				"may only be specified when `level` is 'custom'"))
+		}
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("level"), ""))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("level"), shares.Level, validSharesLevels))
+	}
+
+	return allErrs
+}
+
+func validateClassPolicies(spec *v1alpha1.VirtualMachineClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	hw := &spec.Hardware
+	res := &spec.Policies.Resources
+	reqPath := fldPath.Child("resources", "requests")
+	limPath := fldPath.Child("resources", "limits")
+
+	allErrs = append(allErrs, validateNonNegativeQuantity(res.Requests.Cpu, reqPath.Child("cpu"))...)
+	allErrs = append(allErrs, validateNonNegativeQuantity(res.Requests.Memory, reqPath.Child("memory"))...)
+	allErrs = append(allErrs, validateNonNegativeQuantity(res.Limits.Cpu, limPath.Child("cpu"))...)
+	allErrs = append(allErrs, validateNonNegativeQuantity(res.Limits.Memory, limPath.Child("memory"))...)
+
+	// A zero limit means the resource is unlimited.
+	if !res.Limits.Cpu.IsZero() && res.Requests.Cpu.Cmp(res.Limits.Cpu) > 0 {
+		allErrs = append(allErrs, field.Invalid(reqPath.Child("cpu"), res.Requests.Cpu.String(),
+			"must be less than or equal to the cpu limit"))
+	}
+	if !res.Limits.Memory.IsZero() && res.Requests.Memory.Cmp(res.Limits.Memory) > 0 {
+		allErrs = append(allErrs, field.Invalid(reqPath.Child("memory"), res.Requests.Memory.String(),
+			"must be less than or equal to the memory limit"))
+	}
+
+	// The memory of a VirtualMachine cannot be reserved or limited beyond the memory it is configured with.
+	if hw.Memory.Sign() > 0 {
+		if res.Requests.Memory.Cmp(hw.Memory) > 0 {
+			allErrs = append(allErrs, field.Invalid(reqPath.Child("memory"), res.Requests.Memory.String(),
+				"must be less than or equal to the hardware memory"))
+		}
+		if res.Limits.Memory.Cmp(hw.Memory) > 0 {
+			allErrs = append(allErrs, field.Invalid(limPath.Child("memory"), res.Limits.Memory.String(),
+				"must be less than or equal to the hardware memory"))
+		}
+	}
+
+	// The CPU of a VirtualMachine cannot be reserved or limited beyond the number of CPUs it is configured with.
+	// The CPU requests and limits are expressed in cores, and converted to MHz with the clock speed of the host.
+	if hw.Cpus > 0 {
+		cpus := *resource.NewQuantity(hw.Cpus, resource.DecimalSI)
+		if res.Requests.Cpu.Cmp(cpus) > 0 {
+			allErrs = append(allErrs, field.Invalid(reqPath.Child("cpu"), res.Requests.Cpu.String(),
+				"must be less than or equal to the hardware cpus"))
+		}
+		if res.Limits.Cpu.Cmp(cpus) > 0 {
+			allErrs = append(allErrs, field.Invalid(limPath.Child("cpu"), res.Limits.Cpu.String(),
+				"must be less than or equal to the hardware cpus"))
+		}
+	}
+
+	// vGPU and Dynamic DirectPath I/O devices require the memory of the VirtualMachine to be fully reserved.
+	if len(hw.Devices.VGPUDevices)+len(hw.Devices.DynamicDirectPathIODevices) > 0 &&
+		res.Requests.Memory.Cmp(hw.Memory) != 0 {
+		allErrs = append(allErrs, field.Invalid(reqPath.Child("memory"), res.Requests.Memory.String(),
+			"must be equal to the hardware memory when vGPU or Dynamic DirectPath I/O devices are specified"))
+	}
+
+	// A VirtualMachine with a high latency sensitivity requires its CPU and memory to be fully reserved.
+	if hw.LatencySensitivity == v1alpha1.LatencySensitivityHigh {
+		if res.Requests.Cpu.Cmp(*resource.NewQuantity(hw.Cpus, resource.DecimalSI)) != 0 {
+			allErrs = append(allErrs, field.Invalid(reqPath.Child("cpu"), res.Requests.Cpu.String(),
+				"must be equal to the hardware cpus when `latencySensitivity` is 'high'"))
+		}
+		if res.Requests.Memory.Cmp(hw.Memory) != 0 {
+			allErrs = append(allErrs, field.Invalid(reqPath.Child("memory"), res.Requests.Memory.String(),
+				"must be equal to the hardware memory when `latencySensitivity` is 'high'"))
+		}
+	}
+
+	if devices := spec.Policies.Devices; devices != nil {
+		devicesPath := fldPath.Child("devices")
+		if devices.MaxVGPUDevices < 0 {
+			allErrs = append(allErrs, field.Invalid(devicesPath.Child("maxVGPUDevices"), devices.MaxVGPUDevices,
+				"must be greater than or equal to 0"))
+		} else if devices.MaxVGPUDevices == 0 && len(devices.AllowedVGPUProfileNames) > 0 {
+			allErrs = append(allErrs, field.Required(devicesPath.Child("maxVGPUDevices"),
+				"must be specified when `allowedVGPUProfileNames` is not empty"))
+		}
+		if devices.MaxDynamicDirectPathIODevices < 0 {
+			allErrs = append(allErrs, field.Invalid(devicesPath.Child("maxDynamicDirectPathIODevices"),
+				devices.MaxDynamicDirectPathIODevices, "must be greater than or equal to 0"))
+		} else if devices.MaxDynamicDirectPathIODevices == 0 && len(devices.AllowedDynamicDirectPathIODevices) > 0 {
+			allErrs = append(allErrs, field.Required(devicesPath.Child("maxDynamicDirectPathIODevices"),
+				"must be specified when `allowedDynamicDirectPathIODevices` is not empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNonNegativeQuantity(value resource.Quantity, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if value.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value.String(), "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// ValidateVirtualMachineClassBinding validates that a VirtualMachineClassBinding specifies exactly one of a
+// ClassRef name and a ClassSelector, and that the ClassSelector is valid and not empty, so that a binding does not
+// inadvertently bind every VirtualMachineClass.
+func ValidateVirtualMachineClassBinding(binding *v1alpha1.VirtualMachineClassBinding) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case binding.ClassRef.Name != "" && binding.ClassSelector != nil:
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("classSelector"),
+			"may not be specified when `classRef.name` is specified"))
+	case binding.ClassSelector != nil &&
+		len(binding.ClassSelector.MatchLabels)+len(binding.ClassSelector.MatchExpressions) == 0:
+		allErrs = append(allErrs, field.Invalid(field.NewPath("classSelector"), binding.ClassSelector,
+			"must specify `matchLabels` or `matchExpressions`"))
+	case binding.ClassSelector != nil:
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(binding.ClassSelector,
+			field.NewPath("classSelector"))...)
+	case binding.ClassRef.Name == "":
+		allErrs = append(allErrs, field.Required(field.NewPath("classRef", "name"),
+			"one of `classRef.name` and `classSelector` must be specified"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+var validDeploymentStrategyTypes = []string{
+	string(v1alpha1.RecreateVirtualMachineDeploymentStrategyType),
+	string(v1alpha1.RollingUpdateVirtualMachineDeploymentStrategyType),
+}
+
+// ValidateVirtualMachineDeployment validates the spec of a VirtualMachineDeployment.
+func ValidateVirtualMachineDeployment(d *v1alpha1.VirtualMachineDeployment) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if d.Spec.Replicas != nil && *d.Spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *d.Spec.Replicas,
+			"must be greater than or equal to 0"))
+	}
+	if d.Spec.MinReadySeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("minReadySeconds"), d.Spec.MinReadySeconds,
+			"must be greater than or equal to 0"))
+	}
+	if d.Spec.RevisionHistoryLimit != nil && *d.Spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("revisionHistoryLimit"), *d.Spec.RevisionHistoryLimit,
+			"must be greater than or equal to 0"))
+	}
+	if d.Spec.ProgressDeadlineSeconds != nil && *d.Spec.ProgressDeadlineSeconds <= d.Spec.MinReadySeconds {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("progressDeadlineSeconds"),
+			*d.Spec.ProgressDeadlineSeconds, "must be greater than minReadySeconds"))
+	}
+	if d.Spec.RollbackTo != nil && d.Spec.RollbackTo.Revision < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("rollbackTo", "revision"), d.Spec.RollbackTo.Revision,
+			"must be greater than or equal to 0"))
+	}
+
+	allErrs = append(allErrs, validateSelectorAndTemplate(d.Spec.Selector, &d.Spec.Template, specPath)...)
+	allErrs = append(allErrs, validateDeploymentStrategy(&d.Spec.Strategy, specPath.Child("strategy"))...)
+
+	return allErrs
+}
+
+func validateDeploymentStrategy(strategy *v1alpha1.VirtualMachineDeploymentStrategy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch strategy.Type {
+	case v1alpha1.RecreateVirtualMachineDeploymentStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollingUpdate"),
+				"may not be specified when strategy `type` is 'Recreate'"))
+		}
+	case "", v1alpha1.RollingUpdateVirtualMachineDeploymentStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, validateRollingUpdate(strategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), strategy.Type, validDeploymentStrategyTypes))
+	}
+
+	return allErrs
+}
+
+func validateRollingUpdate(rollingUpdate *v1alpha1.RollingUpdateVirtualMachineDeployment, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if rollingUpdate.MaxUnavailable != nil {
+		allErrs = append(allErrs, validatePositiveIntOrPercent(*rollingUpdate.MaxUnavailable,
+			fldPath.Child("maxUnavailable"))...)
+		if getPercentValue(*rollingUpdate.MaxUnavailable) > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable,
+				"must not be greater than 100%"))
+		}
+	}
+	if rollingUpdate.MaxSurge != nil {
+		allErrs = append(allErrs, validatePositiveIntOrPercent(*rollingUpdate.MaxSurge, fldPath.Child("maxSurge"))...)
+	}
+
+	if isZeroIntOrPercent(rollingUpdate.MaxUnavailable) && isZeroIntOrPercent(rollingUpdate.MaxSurge) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable,
+			"may not be 0 when `maxSurge` is 0"))
+	}
+
+	return allErrs
+}
+
+func validatePositiveIntOrPercent(intOrPercent intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch intOrPercent.Type {
+	case intstr.String:
+		for _, msg := range validation.IsValidPercent(intOrPercent.StrVal) {
+			allErrs = append(allErrs, field.Invalid(fldPath, intOrPercent.StrVal, msg))
+		}
+	case intstr.Int:
+		if intOrPercent.IntVal < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, intOrPercent.IntVal, "must be greater than or equal to 0"))
+		}
+	}
+
+	return allErrs
+}
+
+// getPercentValue returns the percentage value of a valid percent string, or -1 if it is not a percentage.
+func getPercentValue(intOrPercent intstr.IntOrString) int {
+	if intOrPercent.Type != intstr.String || len(validation.IsValidPercent(intOrPercent.StrVal)) != 0 {
+		return -1
+	}
+	value, _ := intstr.GetValueFromIntOrPercent(&intOrPercent, 100, false)
+	return value
+}
+
+// isZeroIntOrPercent returns true when the value is an explicit zero, in either integer or percentage form.  Unset
+// values are defaulted to a non-zero percentage, so they are not considered zero.
+func isZeroIntOrPercent(intOrPercent *intstr.IntOrString) bool {
+	if intOrPercent == nil {
+		return false
+	}
+	if intOrPercent.Type == intstr.Int {
+		return intOrPercent.IntVal == 0
+	}
+	return strings.TrimSuffix(intOrPercent.StrVal, "%") == "0"
+}
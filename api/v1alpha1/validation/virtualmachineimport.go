@@ -0,0 +1,65 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ValidateVirtualMachineImport validates the spec of a VirtualMachineImport.
+func ValidateVirtualMachineImport(vmImport *v1alpha1.VirtualMachineImport) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	sourcePath := specPath.Child("source")
+
+	source := vmImport.Spec.Source
+	switch {
+	case source.InstanceUUID == "" && source.BiosUUID == "":
+		allErrs = append(allErrs, field.Required(sourcePath, "one of `instanceUUID` or `biosUUID` must be specified"))
+	case source.InstanceUUID != "" && source.BiosUUID != "":
+		allErrs = append(allErrs, field.Forbidden(sourcePath.Child("biosUUID"),
+			"may not be specified when `instanceUUID` is specified"))
+	case source.InstanceUUID != "":
+		if !uuidRegexp.MatchString(source.InstanceUUID) {
+			allErrs = append(allErrs, field.Invalid(sourcePath.Child("instanceUUID"), source.InstanceUUID,
+				"must be a valid UUID"))
+		}
+	default:
+		if !uuidRegexp.MatchString(source.BiosUUID) {
+			allErrs = append(allErrs, field.Invalid(sourcePath.Child("biosUUID"), source.BiosUUID,
+				"must be a valid UUID"))
+		}
+	}
+
+	if name := vmImport.Spec.VirtualMachineName; name != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("virtualMachineName"), name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineImportUpdate validates an update to a VirtualMachineImport.  The source and the name of the
+// produced VirtualMachine are immutable.
+func ValidateVirtualMachineImportUpdate(vmImport, oldVMImport *v1alpha1.VirtualMachineImport) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if vmImport.Spec.Source != oldVMImport.Spec.Source {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("source"), "field is immutable"))
+	}
+	if vmImport.Spec.VirtualMachineName != oldVMImport.Spec.VirtualMachineName {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("virtualMachineName"), "field is immutable"))
+	}
+
+	return allErrs
+}
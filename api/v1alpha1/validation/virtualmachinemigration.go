@@ -0,0 +1,116 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+var validMigrationPriorities = []string{
+	string(v1alpha1.HighMigrationPriority),
+	string(v1alpha1.DefaultMigrationPriority),
+	string(v1alpha1.LowMigrationPriority),
+}
+
+// ValidateVirtualMachineMigration validates the spec of a VirtualMachineMigration.
+func ValidateVirtualMachineMigration(migration *v1alpha1.VirtualMachineMigration) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	spec := &migration.Spec
+
+	if spec.VirtualMachineName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("virtualMachineName"), ""))
+	}
+
+	if spec.TargetZone == "" && spec.TargetHostGroup == "" && spec.TargetStorageClass == "" {
+		allErrs = append(allErrs, field.Required(specPath,
+			"at least one of `targetZone`, `targetHostGroup` or `targetStorageClass` must be specified"))
+	}
+	if spec.TargetZone != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(spec.TargetZone) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("targetZone"), spec.TargetZone, msg))
+		}
+	}
+
+	switch spec.Priority {
+	case "", v1alpha1.HighMigrationPriority, v1alpha1.DefaultMigrationPriority, v1alpha1.LowMigrationPriority:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("priority"), spec.Priority,
+			validMigrationPriorities))
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineMigrationUpdate validates an update to a VirtualMachineMigration.  The spec of a
+// VirtualMachineMigration is immutable.
+func ValidateVirtualMachineMigrationUpdate(migration, oldMigration *v1alpha1.VirtualMachineMigration) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if migration.Spec != oldMigration.Spec {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachineMigratable validates that the VirtualMachine referenced by a VirtualMachineMigration is in
+// a state that allows it to be migrated to the requested target.
+func ValidateVirtualMachineMigratable(
+	migration *v1alpha1.VirtualMachineMigration,
+	vm *v1alpha1.VirtualMachine) field.ErrorList {
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	vmPath := specPath.Child("virtualMachineName")
+
+	if vm.DeletionTimestamp != nil {
+		allErrs = append(allErrs, field.Invalid(vmPath, vm.Name, "VirtualMachine is being deleted"))
+	}
+	if vm.Status.Phase != v1alpha1.Created {
+		allErrs = append(allErrs, field.Invalid(vmPath, vm.Name, "VirtualMachine has not been created yet"))
+	}
+	if v1alpha1.IsPaused(vm) {
+		allErrs = append(allErrs, field.Invalid(vmPath, vm.Name, "VirtualMachine reconciliation is paused"))
+	}
+
+	for _, vol := range vm.Spec.Volumes {
+		if vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.InstanceVolumeClaim != nil {
+			allErrs = append(allErrs, field.Invalid(vmPath, vm.Name,
+				"VirtualMachine has instance storage volumes, which cannot be migrated"))
+			break
+		}
+	}
+
+	if t := vm.Spec.Topology; t != nil && migration.Spec.TargetZone != "" {
+		if t.Zone != "" && t.Zone != migration.Spec.TargetZone {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("targetZone"), migration.Spec.TargetZone,
+				"VirtualMachine is pinned to zone "+t.Zone))
+		} else if len(t.AllowedZones) > 0 && !containsString(t.AllowedZones, migration.Spec.TargetZone) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("targetZone"), migration.Spec.TargetZone,
+				"zone is not one of the VirtualMachine's allowed zones"))
+		}
+	}
+
+	if migration.Spec.TargetZone != "" && migration.Spec.TargetZone == vm.Status.Zone &&
+		migration.Spec.TargetHostGroup == "" &&
+		(migration.Spec.TargetStorageClass == "" || migration.Spec.TargetStorageClass == vm.Spec.StorageClass) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("targetZone"), migration.Spec.TargetZone,
+			"VirtualMachine is already placed in the target zone"))
+	}
+
+	return allErrs
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
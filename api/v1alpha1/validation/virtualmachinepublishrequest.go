@@ -0,0 +1,49 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// ValidateVirtualMachinePublishRequest validates the spec of a VirtualMachinePublishRequest.
+func ValidateVirtualMachinePublishRequest(req *v1alpha1.VirtualMachinePublishRequest) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if name := req.Spec.Source.Name; name != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("source", "name"), name, msg))
+		}
+	}
+
+	targetPath := specPath.Child("target")
+	if req.Spec.Target.LibraryName == "" {
+		allErrs = append(allErrs, field.Required(targetPath.Child("libraryName"), ""))
+	}
+	if req.Spec.Target.LibraryItem.Name == "" {
+		allErrs = append(allErrs, field.Required(targetPath.Child("libraryItem", "name"), ""))
+	}
+
+	return allErrs
+}
+
+// ValidateVirtualMachinePublishRequestUpdate validates an update to a VirtualMachinePublishRequest.  The spec of a
+// VirtualMachinePublishRequest is immutable.
+func ValidateVirtualMachinePublishRequestUpdate(req, oldReq *v1alpha1.VirtualMachinePublishRequest) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if req.Spec.Source != oldReq.Spec.Source {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("source"), "field is immutable"))
+	}
+	if req.Spec.Target != oldReq.Spec.Target {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("target"), "field is immutable"))
+	}
+
+	return allErrs
+}
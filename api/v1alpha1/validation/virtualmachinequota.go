@@ -0,0 +1,50 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+var validQuotaResourceNames = []string{
+	string(v1alpha1.VirtualMachineQuotaVirtualMachines),
+	string(v1alpha1.VirtualMachineQuotaCPUs),
+	string(v1alpha1.VirtualMachineQuotaMemory),
+	string(v1alpha1.VirtualMachineQuotaRequestsCPU),
+	string(v1alpha1.VirtualMachineQuotaLimitsCPU),
+	string(v1alpha1.VirtualMachineQuotaRequestsMemory),
+	string(v1alpha1.VirtualMachineQuotaLimitsMemory),
+	string(v1alpha1.VirtualMachineQuotaVGPUs),
+	string(v1alpha1.VirtualMachineQuotaDynamicDirectPathIODevices),
+	string(v1alpha1.VirtualMachineQuotaInstanceStorage),
+	v1alpha1.VirtualMachineQuotaVGPUProfilePrefix + "<profileName>",
+}
+
+// ValidateVirtualMachineQuota validates the spec of a VirtualMachineQuota.
+func ValidateVirtualMachineQuota(quota *v1alpha1.VirtualMachineQuota) field.ErrorList {
+	var allErrs field.ErrorList
+	hardPath := field.NewPath("spec", "hard")
+
+	for name, q := range quota.Spec.Hard {
+		p := hardPath.Key(string(name))
+
+		if strings.HasPrefix(string(name), v1alpha1.VirtualMachineQuotaVGPUProfilePrefix) {
+			profileName := strings.TrimPrefix(string(name), v1alpha1.VirtualMachineQuotaVGPUProfilePrefix)
+			for _, msg := range validation.IsQualifiedName(profileName) {
+				allErrs = append(allErrs, field.Invalid(p, name, msg))
+			}
+		} else if !containsString(validQuotaResourceNames, string(name)) {
+			allErrs = append(allErrs, field.NotSupported(p, name, validQuotaResourceNames))
+		}
+
+		allErrs = append(allErrs, validateNonNegativeQuantity(q, p)...)
+	}
+
+	return allErrs
+}
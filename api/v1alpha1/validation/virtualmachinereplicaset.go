@@ -0,0 +1,64 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// ValidateVirtualMachineReplicaSet validates the spec of a VirtualMachineReplicaSet.
+func ValidateVirtualMachineReplicaSet(rs *v1alpha1.VirtualMachineReplicaSet) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if rs.Spec.Replicas != nil && *rs.Spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *rs.Spec.Replicas,
+			"must be greater than or equal to 0"))
+	}
+	if rs.Spec.MinReadySeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("minReadySeconds"), rs.Spec.MinReadySeconds,
+			"must be greater than or equal to 0"))
+	}
+
+	allErrs = append(allErrs, validateSelectorAndTemplate(rs.Spec.Selector, &rs.Spec.Template, specPath)...)
+
+	return allErrs
+}
+
+// validateSelectorAndTemplate validates that the selector is a non-empty, valid label selector that matches the
+// labels of the template, and that the template itself is valid.
+func validateSelectorAndTemplate(
+	selector *metav1.LabelSelector,
+	template *v1alpha1.VirtualMachineTemplateSpec,
+	fldPath *field.Path) field.ErrorList {
+
+	var allErrs field.ErrorList
+	selectorPath := fldPath.Child("selector")
+	templatePath := fldPath.Child("template")
+
+	if selector == nil {
+		allErrs = append(allErrs, field.Required(selectorPath, ""))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(selector, selectorPath)...)
+		if len(selector.MatchLabels)+len(selector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Invalid(selectorPath, selector, "empty selector is invalid"))
+		} else if s, err := metav1.LabelSelectorAsSelector(selector); err == nil {
+			if !s.Matches(labels.Set(template.ObjectMeta.Labels)) {
+				allErrs = append(allErrs, field.Invalid(templatePath.Child("metadata", "labels"),
+					template.ObjectMeta.Labels, "`selector` does not match template `labels`"))
+			}
+		}
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(template.ObjectMeta.Labels,
+		templatePath.Child("metadata", "labels"))...)
+	allErrs = append(allErrs, ValidateVirtualMachineSpec(&template.Spec, templatePath.Child("spec"))...)
+
+	return allErrs
+}
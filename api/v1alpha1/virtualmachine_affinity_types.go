@@ -0,0 +1,122 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Topology keys that may be used by a VirtualMachineAffinityTerm.
+const (
+	// VirtualMachineAffinityTopologyKeyHost describes the topology domain of a single infrastructure host.
+	// VirtualMachines are considered co-located when they are executing on the same host.
+	VirtualMachineAffinityTopologyKeyHost = "kubernetes.io/hostname"
+
+	// VirtualMachineAffinityTopologyKeyZone describes the topology domain of an availability zone.
+	// VirtualMachines are considered co-located when they are scheduled in the same zone.
+	VirtualMachineAffinityTopologyKeyZone = "topology.kubernetes.io/zone"
+)
+
+// VirtualMachineAffinityTerm defines a set of VirtualMachines, selected by a label selector, that this
+// VirtualMachine should be co-located (affinity) or not co-located (anti-affinity) with.  Co-located is
+// defined as running within the same topology domain, as described by TopologyKey.
+type VirtualMachineAffinityTerm struct {
+	// LabelSelector is a label query over the VirtualMachines, in the same namespace as this VirtualMachine,
+	// that this term applies to.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector"`
+
+	// TopologyKey describes the topology domain within which the VirtualMachines selected by LabelSelector are
+	// considered co-located.  Valid values are "kubernetes.io/hostname" and "topology.kubernetes.io/zone".
+	// +kubebuilder:validation:Enum=kubernetes.io/hostname;topology.kubernetes.io/zone
+	TopologyKey string `json:"topologyKey"`
+}
+
+// WeightedVirtualMachineAffinityTerm associates a weight with a VirtualMachineAffinityTerm.  The weights of all
+// satisfied terms are summed to find the most preferred placement for the VirtualMachine.
+type WeightedVirtualMachineAffinityTerm struct {
+	// Weight associated with matching the corresponding VirtualMachineAffinityTerm, in the range 1-100.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	Weight int32 `json:"weight"`
+
+	// VirtualMachineAffinityTerm is the affinity term associated with the corresponding weight.
+	VirtualMachineAffinityTerm VirtualMachineAffinityTerm `json:"virtualMachineAffinityTerm"`
+}
+
+// VirtualMachineAffinity describes the rules used to co-locate a VirtualMachine with other VirtualMachines.
+type VirtualMachineAffinity struct {
+	// RequiredDuringSchedulingIgnoredDuringExecution describes affinity requirements that must be met when the
+	// VirtualMachine is placed.  If the requirements cease to be met after placement, the VirtualMachine is not
+	// moved, but the violation is reported in the VirtualMachine status.  All terms must be satisfied.
+	// +optional
+	RequiredDuringSchedulingIgnoredDuringExecution []VirtualMachineAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+
+	// PreferredDuringSchedulingIgnoredDuringExecution describes affinity preferences the infrastructure provider
+	// will try to satisfy when the VirtualMachine is placed, but may choose to violate.
+	// +optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedVirtualMachineAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// VirtualMachineAntiAffinity describes the rules used to keep a VirtualMachine apart from other VirtualMachines.
+type VirtualMachineAntiAffinity struct {
+	// RequiredDuringSchedulingIgnoredDuringExecution describes anti-affinity requirements that must be met when the
+	// VirtualMachine is placed.  If the requirements cease to be met after placement, the VirtualMachine is not
+	// moved, but the violation is reported in the VirtualMachine status.  All terms must be satisfied.
+	// +optional
+	RequiredDuringSchedulingIgnoredDuringExecution []VirtualMachineAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+
+	// PreferredDuringSchedulingIgnoredDuringExecution describes anti-affinity preferences the infrastructure
+	// provider will try to satisfy when the VirtualMachine is placed, but may choose to violate.
+	// +optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedVirtualMachineAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// VirtualMachineAffinitySpec describes the VirtualMachine-to-VirtualMachine placement rules of a VirtualMachine.
+type VirtualMachineAffinitySpec struct {
+	// VirtualMachineAffinity describes the rules used to co-locate this VirtualMachine with other VirtualMachines.
+	// +optional
+	VirtualMachineAffinity *VirtualMachineAffinity `json:"vmAffinity,omitempty"`
+
+	// VirtualMachineAntiAffinity describes the rules used to keep this VirtualMachine apart from other
+	// VirtualMachines.
+	// +optional
+	VirtualMachineAntiAffinity *VirtualMachineAntiAffinity `json:"vmAntiAffinity,omitempty"`
+}
+
+// VirtualMachineAffinityRuleType describes the type of the rule that a VirtualMachineAffinityViolation refers to.
+// +kubebuilder:validation:Enum=Affinity;AntiAffinity
+type VirtualMachineAffinityRuleType string
+
+const (
+	// VirtualMachineAffinityRule refers to a rule in VirtualMachineAffinitySpec.VirtualMachineAffinity.
+	VirtualMachineAffinityRule VirtualMachineAffinityRuleType = "Affinity"
+
+	// VirtualMachineAntiAffinityRule refers to a rule in VirtualMachineAffinitySpec.VirtualMachineAntiAffinity.
+	VirtualMachineAntiAffinityRule VirtualMachineAffinityRuleType = "AntiAffinity"
+)
+
+// VirtualMachineAffinityViolation describes an affinity or anti-affinity term of a VirtualMachine that is not
+// satisfied by the current placement of the VirtualMachine.
+type VirtualMachineAffinityViolation struct {
+	// Type describes whether the violated term is an affinity or an anti-affinity term.
+	Type VirtualMachineAffinityRuleType `json:"type"`
+
+	// Required is true when the violated term is a required term, and false when it is a preferred term.
+	Required bool `json:"required"`
+
+	// Index is the index of the violated term in its corresponding required or preferred list.
+	Index int32 `json:"index"`
+
+	// TopologyKey is the topology key of the violated term.
+	TopologyKey string `json:"topologyKey"`
+
+	// VirtualMachines lists the names of the VirtualMachines that cause the term to be violated.  For an affinity
+	// term this list is empty, since the violation is due to no matching VirtualMachine being co-located.
+	// +optional
+	VirtualMachines []string `json:"virtualMachines,omitempty"`
+
+	// Message is a human readable message describing the violation.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UnsatisfiableConstraintAction describes the action taken when a VirtualMachineTopologySpreadConstraint cannot
+// be satisfied.
+// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+type UnsatisfiableConstraintAction string
+
+const (
+	// DoNotSchedule instructs the infrastructure provider not to place the VirtualMachine when the constraint
+	// cannot be satisfied.
+	DoNotSchedule UnsatisfiableConstraintAction = "DoNotSchedule"
+
+	// ScheduleAnyway instructs the infrastructure provider to place the VirtualMachine anyway, while giving
+	// higher precedence to topology domains that would help reduce the skew.
+	ScheduleAnyway UnsatisfiableConstraintAction = "ScheduleAnyway"
+)
+
+// VirtualMachineTopologySpreadConstraint describes how a group of VirtualMachines should be spread across a
+// topology domain.
+type VirtualMachineTopologySpreadConstraint struct {
+	// MaxSkew describes the maximum permitted difference between the number of matching VirtualMachines in any
+	// two topology domains of the given TopologyKey.
+	// +kubebuilder:validation:Minimum:=1
+	MaxSkew int32 `json:"maxSkew"`
+
+	// TopologyKey describes the topology domain that VirtualMachines are spread across.  Valid values are
+	// "kubernetes.io/hostname" and "topology.kubernetes.io/zone".
+	// +kubebuilder:validation:Enum=kubernetes.io/hostname;topology.kubernetes.io/zone
+	TopologyKey string `json:"topologyKey"`
+
+	// WhenUnsatisfiable describes how to handle a VirtualMachine if it does not satisfy the spread constraint.
+	// Valid values are "DoNotSchedule" and "ScheduleAnyway".
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable"`
+
+	// LabelSelector is a label query over the VirtualMachines, in the same namespace as this VirtualMachine,
+	// that are counted to determine the number of VirtualMachines in each topology domain.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// VirtualMachineTopologySpec describes the availability zone placement constraints of a VirtualMachine.
+// Zone and AllowedZones are mutually exclusive.  SpreadConstraints may be combined with either of them.
+type VirtualMachineTopologySpec struct {
+	// Zone describes the name of the AvailabilityZone the VirtualMachine must be placed in.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// AllowedZones describes the names of the AvailabilityZones the VirtualMachine may be placed in.  When empty,
+	// and Zone is not set, the VirtualMachine may be placed in any zone available to its namespace.
+	// +optional
+	AllowedZones []string `json:"allowedZones,omitempty"`
+
+	// SpreadConstraints describes how this VirtualMachine and the VirtualMachines matching each constraint's
+	// LabelSelector should be spread across topology domains.  All constraints are ANDed.
+	// +optional
+	SpreadConstraints []VirtualMachineTopologySpreadConstraint `json:"spreadConstraints,omitempty"`
+}
@@ -49,6 +49,9 @@ const (
 //
 // This can be used when a Virtual Machine needs to be modified out-of-band of VM Operator on the infrastructure
 // directly (e.g., during a VADP based Restore operation).
+//
+// The annotation pauses reconciliation indefinitely.  Use the spec.pause field to record why reconciliation is
+// paused, who requested it, and when it should automatically resume.  See IsPaused.
 const PauseAnnotation = GroupName + "/pause-reconcile"
 
 // VirtualMachinePort is unused and can be considered deprecated.
@@ -231,6 +234,56 @@ type Probe struct {
 	// +optional
 	// +kubebuilder:validation:Minimum:=1
 	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// FailureThreshold specifies the number of consecutive failed probes after which the probe is considered
+	// failed.  This is only used by the LivenessProbe.  Defaults to 3. Minimum value is 1.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// VirtualMachineRestartPolicy describes when a VirtualMachine is automatically restarted.
+// +kubebuilder:validation:Enum=Always;OnFailure;Never
+type VirtualMachineRestartPolicy string
+
+const (
+	// VirtualMachineRestartPolicyAlways restarts the VirtualMachine when its LivenessProbe fails, and powers the
+	// VirtualMachine back on when it is powered off while its desired power state is "poweredOn".
+	VirtualMachineRestartPolicyAlways VirtualMachineRestartPolicy = "Always"
+
+	// VirtualMachineRestartPolicyOnFailure restarts the VirtualMachine only when its LivenessProbe fails.
+	VirtualMachineRestartPolicyOnFailure VirtualMachineRestartPolicy = "OnFailure"
+
+	// VirtualMachineRestartPolicyNever never restarts the VirtualMachine automatically.
+	VirtualMachineRestartPolicyNever VirtualMachineRestartPolicy = "Never"
+)
+
+// VirtualMachineRestartReason describes why a VirtualMachine was automatically restarted.
+type VirtualMachineRestartReason string
+
+const (
+	// LivenessProbeFailedRestartReason indicates the VirtualMachine was reset because its LivenessProbe failed
+	// FailureThreshold consecutive times.
+	LivenessProbeFailedRestartReason VirtualMachineRestartReason = "LivenessProbeFailed"
+
+	// UnexpectedPowerOffRestartReason indicates the VirtualMachine was powered back on because it was powered off,
+	// e.g. by a shutdown from within the guest, while its desired power state is "poweredOn".
+	UnexpectedPowerOffRestartReason VirtualMachineRestartReason = "UnexpectedPowerOff"
+)
+
+// VirtualMachineRestartBackoff describes the exponential backoff applied between consecutive automatic restarts of
+// a VirtualMachine.  The delay starts at InitialDelaySeconds and doubles after each restart, up to MaxDelaySeconds.
+// The delay is reset once the VirtualMachine has been running without a restart for MaxDelaySeconds.
+type VirtualMachineRestartBackoff struct {
+	// InitialDelaySeconds is the delay before the first automatic restart.  Defaults to 10 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// MaxDelaySeconds is the maximum delay between automatic restarts.  Defaults to 300 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	MaxDelaySeconds int32 `json:"maxDelaySeconds,omitempty"`
 }
 
 // TCPSocketAction describes an action based on opening a socket.
@@ -279,8 +332,15 @@ type VirtualMachineSpec struct {
 
 	// ClassName describes the name of a VirtualMachineClass that is to be used as the overlaid resource configuration
 	// of VirtualMachine.  A VirtualMachineClass is used to further customize the attributes of the VirtualMachine
-	// instance.  See VirtualMachineClass for more description.
-	ClassName string `json:"className"`
+	// instance.  See VirtualMachineClass for more description.  When empty, Resources must be specified.
+	// +optional
+	ClassName string `json:"className,omitempty"`
+
+	// Resources describes the resources required by the VirtualMachine when ClassName is empty.  The smallest
+	// VirtualMachineClass available to the namespace that satisfies them is selected, and recorded in the status.
+	// Resources may not be changed while the VirtualMachine is powered on.
+	// +optional
+	Resources *VirtualMachineResourceRequirements `json:"resources,omitempty"`
 
 	// PowerState describes the desired power state of a VirtualMachine.  Valid power states are "poweredOff" and "poweredOn".
 	PowerState VirtualMachinePowerState `json:"powerState"`
@@ -322,8 +382,48 @@ type VirtualMachineSpec struct {
 	// +optional
 	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
 
+	// LivenessProbe describes a probe that is used to determine if the VirtualMachine is alive.  When the probe fails
+	// FailureThreshold consecutive times, the VirtualMachine is reset according to the RestartPolicy.
+	// +optional
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// RestartPolicy describes when the VirtualMachine is automatically restarted.  Valid values are "Always",
+	// "OnFailure" and "Never".  Defaults to "Never".
+	// +optional
+	RestartPolicy VirtualMachineRestartPolicy `json:"restartPolicy,omitempty"`
+
+	// RestartBackoff describes the backoff applied between consecutive automatic restarts of the VirtualMachine.
+	// +optional
+	RestartBackoff *VirtualMachineRestartBackoff `json:"restartBackoff,omitempty"`
+
 	// AdvancedOptions describes a set of optional, advanced options for configuring a VirtualMachine
 	AdvancedOptions *VirtualMachineAdvancedOptions `json:"advancedOptions,omitempty"`
+
+	// Affinity describes the affinity and anti-affinity rules used to place this VirtualMachine relative to other
+	// VirtualMachines in the same namespace.
+	// +optional
+	Affinity *VirtualMachineAffinitySpec `json:"affinity,omitempty"`
+
+	// Topology describes the availability zone placement constraints of this VirtualMachine.  The zone the
+	// VirtualMachine is eventually placed in is reported in the status.zone field.
+	// +optional
+	Topology *VirtualMachineTopologySpec `json:"topology,omitempty"`
+
+	// Pause describes a request to stop VM Operator from reconciling this VirtualMachine with the vSphere
+	// infrastructure.  The Paused condition reports whether reconciliation is currently paused.
+	// +optional
+	Pause *PauseSpec `json:"pause,omitempty"`
+
+	// AdditionalDevices describes vGPU and Dynamic DirectPath I/O devices to attach to the VirtualMachine in addition
+	// to the devices of its VirtualMachineClass.  The devices must be allowed by the devices policy of the
+	// VirtualMachineClass.
+	// +optional
+	AdditionalDevices *VirtualDevices `json:"additionalDevices,omitempty"`
+
+	// Crypto describes the encryption of the VirtualMachine.  The VirtualMachineEncryptionSynced condition reports
+	// whether the VirtualMachine has been encrypted as requested.
+	// +optional
+	Crypto *VirtualMachineCryptoSpec `json:"crypto,omitempty"`
 }
 
 // AdvancedOptions describes a set of optional, advanced options for configuring a VirtualMachine
@@ -335,6 +435,108 @@ type VirtualMachineAdvancedOptions struct {
 	// ChangeBlockTracking specifies the enablement of incremental backup support for this VirtualMachine, which can be utilized
 	// by external backup systems such as VMware Data Recovery.
 	ChangeBlockTracking *bool `json:"changeBlockTracking,omitempty"`
+
+	// Firmware specifies the firmware interface of the VirtualMachine.  Valid values are "bios" and "efi".  When
+	// unset, the default firmware of the guest OS of the VirtualMachineImage is used.
+	// +optional
+	Firmware VirtualMachineFirmware `json:"firmware,omitempty"`
+
+	// VirtualTPM specifies whether a virtual Trusted Platform Module device is added to the VirtualMachine.  A
+	// vTPM requires Firmware to be explicitly set to "efi", since the default firmware of the guest OS is only known
+	// once the VirtualMachine is deployed, and the VirtualMachine to be encrypted, either by the Crypto spec or by
+	// the default key provider.
+	// +optional
+	VirtualTPM *bool `json:"virtualTPM,omitempty"`
+}
+
+// VirtualMachineResourceRequirements describes the resources required by a VirtualMachine, used to select its
+// VirtualMachineClass.  A VirtualMachineClass satisfies them when it provides at least the requested number of
+// CPUs, memory and devices.
+type VirtualMachineResourceRequirements struct {
+	// Cpus is the minimum number of virtual CPUs.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	Cpus int64 `json:"cpus,omitempty"`
+
+	// Memory is the minimum size of the memory.
+	// +optional
+	Memory resource.Quantity `json:"memory,omitempty"`
+
+	// VGPUDevices is the minimum number of vGPU devices.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	VGPUDevices int32 `json:"vgpuDevices,omitempty"`
+
+	// VGPUProfileNames lists the vGPU profiles the vGPU devices may use.  When empty, any profile may be used.
+	// +optional
+	VGPUProfileNames []string `json:"vgpuProfileNames,omitempty"`
+
+	// DynamicDirectPathIODevices is the minimum number of Dynamic DirectPath I/O devices.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	DynamicDirectPathIODevices int32 `json:"dynamicDirectPathIODevices,omitempty"`
+}
+
+// VirtualMachineFirmware describes the firmware interface of a VirtualMachine.
+// +kubebuilder:validation:Enum=bios;efi
+type VirtualMachineFirmware string
+
+// See govmomi.vim25.types.GuestOsDescriptorFirmwareType
+const (
+	// BIOSFirmware is the legacy BIOS firmware.
+	BIOSFirmware VirtualMachineFirmware = "bios"
+
+	// EFIFirmware is the Extensible Firmware Interface.
+	EFIFirmware VirtualMachineFirmware = "efi"
+)
+
+// VirtualMachineCryptoSpec describes the encryption of a VirtualMachine.  At most one of EncryptionClassName and
+// KeyProviderID may be specified.  When neither is specified, the default key provider is used.
+type VirtualMachineCryptoSpec struct {
+	// EncryptionClassName is the name of the EncryptionClass, in the same namespace as the VirtualMachine, that
+	// describes the key provider and key used to encrypt the VirtualMachine.
+	// +optional
+	EncryptionClassName string `json:"encryptionClassName,omitempty"`
+
+	// KeyProviderID is the ID of the vSphere key provider used to encrypt the VirtualMachine.
+	// +optional
+	KeyProviderID string `json:"keyProviderID,omitempty"`
+
+	// EncryptDisksByDefault specifies whether the disks of the VirtualMachine, including the volumes it exclusively
+	// owns, are encrypted when their StorageClass does not specify otherwise.  Defaults to true.
+	// +optional
+	EncryptDisksByDefault *bool `json:"encryptDisksByDefault,omitempty"`
+}
+
+// VirtualMachineEncryptionType describes a part of a VirtualMachine that is encrypted.
+type VirtualMachineEncryptionType string
+
+const (
+	// VirtualMachineEncryptionTypeConfig indicates the VirtualMachine's configuration files, such as its VMX and
+	// NVRAM files, are encrypted.
+	VirtualMachineEncryptionTypeConfig VirtualMachineEncryptionType = "Config"
+
+	// VirtualMachineEncryptionTypeDisks indicates at least one of the VirtualMachine's disks is encrypted.
+	VirtualMachineEncryptionTypeDisks VirtualMachineEncryptionType = "Disks"
+)
+
+// VirtualMachineCryptoStatus describes the observed encryption state of a VirtualMachine.
+type VirtualMachineCryptoStatus struct {
+	// Encrypted lists the parts of the VirtualMachine that are encrypted.
+	// +optional
+	Encrypted []VirtualMachineEncryptionType `json:"encrypted,omitempty"`
+
+	// ProviderID is the ID of the key provider used to encrypt the VirtualMachine.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// KeyID is the ID of the key used to encrypt the VirtualMachine.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// HasVTPM indicates whether the VirtualMachine has a virtual Trusted Platform Module device.
+	// +optional
+	HasVTPM bool `json:"hasVTPM,omitempty"`
 }
 
 // VirtualMachineVolumeProvisioningOptions specifies the provisioning options for a VirtualMachineVolume.
@@ -366,6 +568,65 @@ type VirtualMachineVolumeStatus struct {
 	Error string `json:"error"`
 }
 
+// VirtualMachineDeviceType describes the type of a device attached to a VirtualMachine.
+type VirtualMachineDeviceType string
+
+const (
+	// VGPUDeviceType is a vGPU device.
+	VGPUDeviceType VirtualMachineDeviceType = "VGPU"
+
+	// DynamicDirectPathIODeviceType is a Dynamic DirectPath I/O device.
+	DynamicDirectPathIODeviceType VirtualMachineDeviceType = "DynamicDirectPathIO"
+)
+
+// VirtualMachineDeviceSource describes where the request for a device attached to a VirtualMachine originates.
+type VirtualMachineDeviceSource string
+
+const (
+	// DeviceSourceClass indicates the device is requested by the VirtualMachineClass.
+	DeviceSourceClass VirtualMachineDeviceSource = "Class"
+
+	// DeviceSourceVirtualMachine indicates the device is requested by the VirtualMachine's AdditionalDevices.
+	DeviceSourceVirtualMachine VirtualMachineDeviceSource = "VirtualMachine"
+)
+
+// VirtualMachineDeviceStatus defines the observed state of a vGPU or Dynamic DirectPath I/O device of a
+// VirtualMachine.
+type VirtualMachineDeviceStatus struct {
+	// Type is the type of the device.
+	Type VirtualMachineDeviceType `json:"type"`
+
+	// Source describes whether the device is requested by the VirtualMachineClass or the VirtualMachine.
+	Source VirtualMachineDeviceSource `json:"source"`
+
+	// ProfileName is the vGPU profile of a VGPU device.
+	// +optional
+	ProfileName string `json:"profileName,omitempty"`
+
+	// VendorID is the vendor ID of a Dynamic DirectPath I/O device.
+	// +optional
+	VendorID int `json:"vendorID,omitempty"`
+
+	// DeviceID is the device ID of a Dynamic DirectPath I/O device.
+	// +optional
+	DeviceID int `json:"deviceID,omitempty"`
+
+	// CustomLabel is the custom label of a Dynamic DirectPath I/O device.
+	// +optional
+	CustomLabel string `json:"customLabel,omitempty"`
+
+	// DeviceKey is the vSphere device key of the device, when attached.
+	// +optional
+	DeviceKey *int32 `json:"deviceKey,omitempty"`
+
+	// Attached represents whether the device has been successfully attached to the VirtualMachine or not.
+	Attached bool `json:"attached"`
+
+	// Error represents the last error seen when attaching the device.  Error will be empty if attachment succeeds.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
 // NetworkInterfaceStatus defines the observed state of network interfaces attached to the VirtualMachine
 // as seen by the Guest OS and VMware tools
 type NetworkInterfaceStatus struct {
@@ -380,6 +641,49 @@ type NetworkInterfaceStatus struct {
 	IpAddresses []string `json:"ipAddresses,omitempty"`
 }
 
+// VirtualMachineResizeState describes the state of an in-place resize of a VirtualMachine to another
+// VirtualMachineClass.
+type VirtualMachineResizeState string
+
+const (
+	// VirtualMachineResizePendingReboot indicates that the VirtualMachine has been reconfigured with the new
+	// VirtualMachineClass, but the change takes effect at the next power cycle of the VirtualMachine.
+	VirtualMachineResizePendingReboot VirtualMachineResizeState = "PendingReboot"
+
+	// VirtualMachineResizeApplied indicates that the VirtualMachine runs with the resources of the new
+	// VirtualMachineClass.
+	VirtualMachineResizeApplied VirtualMachineResizeState = "Applied"
+
+	// VirtualMachineResizeRejected indicates that the VirtualMachine cannot be resized to the new
+	// VirtualMachineClass.  The Reason and Message describe why.
+	VirtualMachineResizeRejected VirtualMachineResizeState = "Rejected"
+)
+
+// VirtualMachineResizeStatus describes the last in-place resize of a VirtualMachine to another VirtualMachineClass.
+type VirtualMachineResizeStatus struct {
+	// ClassName is the name of the VirtualMachineClass the VirtualMachine is being resized to.
+	ClassName string `json:"className"`
+
+	// PreviousClassName is the name of the VirtualMachineClass the VirtualMachine was resized from.
+	// +optional
+	PreviousClassName string `json:"previousClassName,omitempty"`
+
+	// State describes the state of the resize.
+	State VirtualMachineResizeState `json:"state"`
+
+	// Reason is a brief CamelCase string that describes why the resize is in its State.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable message describing the State of the resize.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the time at which the resize last changed State.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
 // VirtualMachineStatus defines the observed state of a VirtualMachine instance.
 type VirtualMachineStatus struct {
 	// Host describes the hostname or IP address of the infrastructure host that the VirtualMachine is executing on.
@@ -436,6 +740,51 @@ type VirtualMachineStatus struct {
 	// Please note this field may be empty when the cluster is not zone-aware.
 	// +optional
 	Zone string `json:"zone,omitempty"`
+
+	// AffinityViolations describes the affinity and anti-affinity terms of the VirtualMachine that are not satisfied
+	// by its current placement.
+	// +optional
+	AffinityViolations []VirtualMachineAffinityViolation `json:"affinityViolations,omitempty"`
+
+	// RestartCount describes the number of times the VirtualMachine has been automatically restarted according to
+	// its RestartPolicy.
+	// +optional
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// LastRestartTime describes when the VirtualMachine was last automatically restarted.
+	// +optional
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+
+	// LastRestartReason describes why the VirtualMachine was last automatically restarted.
+	// +optional
+	LastRestartReason VirtualMachineRestartReason `json:"lastRestartReason,omitempty"`
+
+	// Devices describes the vGPU and Dynamic DirectPath I/O devices of the VirtualMachine, requested either by its
+	// VirtualMachineClass or by its AdditionalDevices, and whether they are attached.
+	// +optional
+	Devices []VirtualMachineDeviceStatus `json:"devices,omitempty"`
+
+	// Crypto describes the observed encryption state of the VirtualMachine.
+	// +optional
+	Crypto *VirtualMachineCryptoStatus `json:"crypto,omitempty"`
+
+	// Resize describes the last in-place resize of the VirtualMachine to another VirtualMachineClass.
+	// +optional
+	Resize *VirtualMachineResizeStatus `json:"resize,omitempty"`
+
+	// SelectedClassName is the name of the VirtualMachineClass selected for the Resources of the VirtualMachine,
+	// when its ClassName is empty.
+	// +optional
+	SelectedClassName string `json:"selectedClassName,omitempty"`
+}
+
+// EffectiveClassName returns the name of the VirtualMachineClass used by the VirtualMachine: its ClassName, or the
+// class selected for its Resources when ClassName is empty.
+func (vm *VirtualMachine) EffectiveClassName() string {
+	if vm.Spec.ClassName != "" {
+		return vm.Spec.ClassName
+	}
+	return vm.Status.SelectedClassName
 }
 
 func (vm *VirtualMachine) GetConditions() Conditions {
@@ -452,8 +801,10 @@ func (vm *VirtualMachine) SetConditions(conditions Conditions) {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="PowerState",type="string",JSONPath=".status.powerState"
 // +kubebuilder:printcolumn:name="Class",type="string",priority=1,JSONPath=".spec.className"
+// +kubebuilder:printcolumn:name="SelectedClass",type="string",priority=1,JSONPath=".status.selectedClassName"
 // +kubebuilder:printcolumn:name="Image",type="string",priority=1,JSONPath=".spec.imageName"
 // +kubebuilder:printcolumn:name="Primary-IP",type="string",priority=1,JSONPath=".status.vmIp"
+// +kubebuilder:printcolumn:name="Restarts",type="integer",priority=1,JSONPath=".status.restartCount"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // VirtualMachine is the Schema for the virtualmachines API.
@@ -0,0 +1,159 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupPhase indicates the phase of a VirtualMachineBackup or a VirtualMachineRestore.
+type BackupPhase string
+
+const (
+	// BackupPending phase indicates that the request has been accepted, but has not started yet.
+	BackupPending BackupPhase = "Pending"
+
+	// BackupInProgress phase indicates that the backup or the restore is running.
+	BackupInProgress BackupPhase = "InProgress"
+
+	// BackupCompleted phase indicates that the backup or the restore completed successfully.
+	BackupCompleted BackupPhase = "Completed"
+
+	// BackupFailed phase indicates that the backup or the restore failed.  The conditions describe the failure.
+	BackupFailed BackupPhase = "Failed"
+)
+
+// VirtualMachineBackupSpec defines the desired state of a VirtualMachineBackup.
+type VirtualMachineBackupSpec struct {
+	// VirtualMachineName is the name of the VirtualMachine, in the same namespace as the VirtualMachineBackup,
+	// that is backed up.
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// Quiesce indicates that the guest file systems should be quiesced, using VMware Tools, before the disks are
+	// captured, so that the backup is application consistent.  Otherwise, the backup is crash consistent.
+	// +optional
+	Quiesce bool `json:"quiesce,omitempty"`
+
+	// BaseBackupName is the name of a completed VirtualMachineBackup, of the same VirtualMachine, that this backup
+	// is incremental to.  Only the disk areas changed since the ChangeID recorded by the base backup are exported.
+	// This requires ChangeBlockTracking to be enabled on the VirtualMachine.  When empty, a full backup is taken.
+	// +optional
+	BaseBackupName string `json:"baseBackupName,omitempty"`
+}
+
+// VirtualMachineBackupDiskStatus describes a disk captured by a VirtualMachineBackup.
+type VirtualMachineBackupDiskStatus struct {
+	// VolumeName is the name of the VirtualMachineVolume the disk belongs to.  It is empty for the boot disk of
+	// the VirtualMachine, which is not described by a VirtualMachineVolume.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty"`
+
+	// ClaimName is the name of the PersistentVolumeClaim that backs the disk, if any.
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
+
+	// DiskUUID is the UUID of the virtual disk.
+	DiskUUID string `json:"diskUUID"`
+
+	// Capacity is the capacity of the virtual disk.
+	// +optional
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+
+	// ChangeID is the change block tracking change ID of the disk at the time it was captured.  Subsequent
+	// incremental backups export the disk areas changed since this ChangeID.
+	// +optional
+	ChangeID string `json:"changeID,omitempty"`
+}
+
+// VirtualMachineBackupStatus defines the observed state of a VirtualMachineBackup.
+type VirtualMachineBackupStatus struct {
+	// Phase indicates the phase of the VirtualMachineBackup.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// VirtualMachineSpec is the spec of the VirtualMachine at the time it was backed up.
+	// +optional
+	VirtualMachineSpec *VirtualMachineSpec `json:"virtualMachineSpec,omitempty"`
+
+	// VirtualMachineLabels are the labels of the VirtualMachine at the time it was backed up.
+	// +optional
+	VirtualMachineLabels map[string]string `json:"virtualMachineLabels,omitempty"`
+
+	// ClassSpec is the spec of the VirtualMachineClass referenced by the VirtualMachine at the time it was backed
+	// up, so that the VirtualMachine can be restored even if the class has since changed or been removed.
+	// +optional
+	ClassSpec *VirtualMachineClassSpec `json:"classSpec,omitempty"`
+
+	// MetadataResourceVersion is the resource version of the ConfigMap or Secret referenced by the
+	// VirtualMachine's VmMetadata at the time it was backed up.  The ConfigMap or Secret is not part of the backup.
+	// +optional
+	MetadataResourceVersion string `json:"metadataResourceVersion,omitempty"`
+
+	// Disks describes the disks captured by the backup.
+	// +optional
+	Disks []VirtualMachineBackupDiskStatus `json:"disks,omitempty"`
+
+	// Incremental is true when the backup is incremental to the backup named by BaseBackupName.
+	// +optional
+	Incremental bool `json:"incremental,omitempty"`
+
+	// StartTime is the time at which the backup was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time at which the backup completed, successfully or not.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineBackup.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (backup *VirtualMachineBackup) GetConditions() Conditions {
+	return backup.Status.Conditions
+}
+
+func (backup *VirtualMachineBackup) SetConditions(conditions Conditions) {
+	backup.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmbackup
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",JSONPath=".spec.virtualMachineName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Incremental",type="boolean",priority=1,JSONPath=".status.incremental"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineBackup is the Schema for the virtualmachinebackups API.
+// A VirtualMachineBackup captures a consistent point-in-time copy of a VirtualMachine: its spec, the
+// VirtualMachineClass it references, references to its metadata and its disks, along with the change block tracking
+// change ID of each disk, so that external backup systems can export the disks incrementally.
+type VirtualMachineBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineBackupSpec   `json:"spec,omitempty"`
+	Status VirtualMachineBackupStatus `json:"status,omitempty"`
+}
+
+func (backup *VirtualMachineBackup) NamespacedName() string {
+	return backup.Namespace + "/" + backup.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineBackupList contains a list of VirtualMachineBackup.
+type VirtualMachineBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineBackup `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineBackup{}, &VirtualMachineBackupList{})
+}
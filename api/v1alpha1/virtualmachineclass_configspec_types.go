@@ -0,0 +1,164 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// StructuredConfigSpec is the structured form of the supported subset of a vim.vm.ConfigSpec: the number of CPUs
+// and their topology, the memory size, the hyperthread sharing, the CPU and memory shares, the latency sensitivity,
+// the extra configuration and the changes to network interfaces, disks, vGPU and Dynamic DirectPath I/O devices.
+type StructuredConfigSpec struct {
+	// NumCPUs is the number of virtual CPUs.  Zero means unset.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	NumCPUs int32 `json:"numCPUs,omitempty"`
+
+	// NumCoresPerSocket is the number of cores per virtual CPU socket.  NumCPUs must be a multiple of
+	// NumCoresPerSocket.  Zero means unset.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	NumCoresPerSocket int32 `json:"numCoresPerSocket,omitempty"`
+
+	// MemoryMB is the size of the memory, in MB.  Zero means unset.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	MemoryMB int64 `json:"memoryMB,omitempty"`
+
+	// HyperthreadSharing describes how the virtual CPUs may share the physical cores of a hyperthreaded host.
+	// +optional
+	HyperthreadSharing VirtualMachineHyperthreadSharing `json:"hyperthreadSharing,omitempty"`
+
+	// CpuShares describes the relative priority of the virtual machine for CPU when there is contention.
+	// +optional
+	CpuShares *VirtualMachineSharesSpec `json:"cpuShares,omitempty"`
+
+	// MemoryShares describes the relative priority of the virtual machine for memory when there is contention.
+	// +optional
+	MemoryShares *VirtualMachineSharesSpec `json:"memoryShares,omitempty"`
+
+	// LatencySensitivity is the latency sensitivity of the virtual machine.
+	// +optional
+	LatencySensitivity VirtualMachineLatencySensitivity `json:"latencySensitivity,omitempty"`
+
+	// Firmware is the firmware interface of the virtual machine.  Valid values are "bios" and "efi".
+	// +optional
+	Firmware VirtualMachineFirmware `json:"firmware,omitempty"`
+
+	// DeviceChanges are the changes applied to the virtual devices, in order.
+	// +optional
+	DeviceChanges []ConfigSpecDeviceChange `json:"deviceChanges,omitempty"`
+
+	// ExtraConfig are additional configuration key/value pairs.
+	// +optional
+	ExtraConfig map[string]string `json:"extraConfig,omitempty"`
+}
+
+// ConfigSpecDeviceOperation is the operation of a ConfigSpecDeviceChange.
+// +kubebuilder:validation:Enum=add;remove;edit
+type ConfigSpecDeviceOperation string
+
+// See govmomi.vim25.types.VirtualDeviceConfigSpecOperation
+const (
+	ConfigSpecDeviceOperationAdd    ConfigSpecDeviceOperation = "add"
+	ConfigSpecDeviceOperationRemove ConfigSpecDeviceOperation = "remove"
+	ConfigSpecDeviceOperationEdit   ConfigSpecDeviceOperation = "edit"
+)
+
+// ConfigSpecDeviceFileOperation is the operation applied to the file backing a device, such as the VMDK of a disk.
+// +kubebuilder:validation:Enum=create;destroy;replace
+type ConfigSpecDeviceFileOperation string
+
+// See govmomi.vim25.types.VirtualDeviceConfigSpecFileOperation
+const (
+	ConfigSpecDeviceFileOperationCreate  ConfigSpecDeviceFileOperation = "create"
+	ConfigSpecDeviceFileOperationDestroy ConfigSpecDeviceFileOperation = "destroy"
+	ConfigSpecDeviceFileOperationReplace ConfigSpecDeviceFileOperation = "replace"
+)
+
+// ConfigSpecDeviceChange is a change to a virtual device.
+type ConfigSpecDeviceChange struct {
+	// Operation is the operation applied to the device.
+	Operation ConfigSpecDeviceOperation `json:"operation"`
+
+	// FileOperation is the operation applied to the file backing the device.
+	// +optional
+	FileOperation ConfigSpecDeviceFileOperation `json:"fileOperation,omitempty"`
+
+	// Device is the device the change applies to.
+	Device ConfigSpecDevice `json:"device"`
+}
+
+// ConfigSpecDevice is a virtual device.  Exactly one of NIC, Disk, VGPU and DynamicDirectPathIO must be specified.
+type ConfigSpecDevice struct {
+	// Type is the vim type name of the device, e.g. "VirtualE1000e".  Defaults to "VirtualVmxnet3" for a NIC,
+	// "VirtualDisk" for a Disk and "VirtualPCIPassthrough" for a VGPU or a DynamicDirectPathIO device.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Key identifies the device within the virtual machine.  Negative keys refer to devices being added.
+	// +optional
+	Key int32 `json:"key,omitempty"`
+
+	// ControllerKey is the key of the controller the device is attached to.
+	// +optional
+	ControllerKey *int32 `json:"controllerKey,omitempty"`
+
+	// UnitNumber is the unit number of the device on its controller.
+	// +optional
+	UnitNumber *int32 `json:"unitNumber,omitempty"`
+
+	// +optional
+	NIC *ConfigSpecNIC `json:"nic,omitempty"`
+
+	// +optional
+	Disk *ConfigSpecDisk `json:"disk,omitempty"`
+
+	// +optional
+	VGPU *VGPUDevice `json:"vgpu,omitempty"`
+
+	// +optional
+	DynamicDirectPathIO *ConfigSpecDynamicDirectPathIO `json:"dynamicDirectPathIO,omitempty"`
+}
+
+// ConfigSpecNIC describes a virtual network interface.
+type ConfigSpecNIC struct {
+	// AddressType is the MAC address type: "manual", "generated" or "assigned".
+	// +optional
+	AddressType string `json:"addressType,omitempty"`
+
+	// MacAddress is the MAC address, when AddressType is "manual".
+	// +optional
+	MacAddress string `json:"macAddress,omitempty"`
+}
+
+// ConfigSpecDisk describes a virtual disk backed by a flat VMDK.
+type ConfigSpecDisk struct {
+	// Capacity is the capacity of the disk.
+	Capacity resource.Quantity `json:"capacity"`
+
+	// FileName is the datastore path of the VMDK backing the disk.
+	// +optional
+	FileName string `json:"fileName,omitempty"`
+
+	// DiskMode is the disk persistence mode, e.g. "persistent".
+	// +optional
+	DiskMode string `json:"diskMode,omitempty"`
+
+	// ThinProvisioned indicates whether the disk is thin provisioned.
+	// +optional
+	ThinProvisioned *bool `json:"thinProvisioned,omitempty"`
+}
+
+// ConfigSpecDynamicDirectPathIO describes a Dynamic DirectPath I/O device.
+type ConfigSpecDynamicDirectPathIO struct {
+	// AllowedDevices lists the physical devices the device may be backed by.  Only the VendorID and DeviceID of
+	// each entry are considered.
+	AllowedDevices []DynamicDirectPathIODevice `json:"allowedDevices"`
+
+	// CustomLabel is the custom label of the physical device the device is backed by.
+	// +optional
+	CustomLabel string `json:"customLabel,omitempty"`
+}
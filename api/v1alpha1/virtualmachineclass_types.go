@@ -10,12 +10,17 @@ import (
 
 // VirtualMachineConfigSpec contains additional virtual machine
 // configuration settings including hardware specifications for the VirtualMachine.
-// We only support XML for now, but that may change in the future.
+// Exactly one of XML and Structured must be specified.
 type VirtualMachineConfigSpec struct {
 	// XML contains a vim.vm.ConfigSpec object that has been serialized to XML
 	// and base64-encoded.
 	// +optional
 	XML string `json:"xml,omitempty"`
+
+	// Structured contains the supported subset of a vim.vm.ConfigSpec object
+	// in a form that can be authored and reviewed in plain YAML.
+	// +optional
+	Structured *StructuredConfigSpec `json:"structured,omitempty"`
 }
 
 // VGPUDevice contains the configuration corresponding to a vGPU device.
@@ -69,11 +74,125 @@ type VirtualMachineClassHardware struct {
 	Devices VirtualDevices `json:"devices,omitempty"`
 	// +optional
 	InstanceStorage InstanceStorage `json:"instanceStorage,omitempty"`
+
+	// CpuHotAddEnabled specifies whether CPUs may be added to a powered on VirtualMachine that uses this
+	// VirtualMachineClass, allowing the VirtualMachine to be resized in place to a class with more CPUs.
+	// +optional
+	CpuHotAddEnabled bool `json:"cpuHotAddEnabled,omitempty"`
+
+	// MemoryHotAddEnabled specifies whether memory may be added to a powered on VirtualMachine that uses this
+	// VirtualMachineClass, allowing the VirtualMachine to be resized in place to a class with more memory.
+	// +optional
+	MemoryHotAddEnabled bool `json:"memoryHotAddEnabled,omitempty"`
+
+	// CoresPerSocket is the number of cores per virtual CPU socket.  Cpus must be a multiple of CoresPerSocket.
+	// When unset, each virtual CPU is a socket with a single core.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	CoresPerSocket int32 `json:"coresPerSocket,omitempty"`
+
+	// NUMA describes the NUMA topology of a VirtualMachine that uses this VirtualMachineClass.
+	// +optional
+	NUMA *VirtualMachineNUMASpec `json:"numa,omitempty"`
+
+	// HyperthreadSharing describes how the virtual CPUs may share the physical cores of a hyperthreaded host.  Valid
+	// values are "any", "none" and "internal".  Defaults to "any".
+	// +optional
+	HyperthreadSharing VirtualMachineHyperthreadSharing `json:"hyperthreadSharing,omitempty"`
+
+	// LatencySensitivity is the latency sensitivity of a VirtualMachine that uses this VirtualMachineClass.  Valid
+	// values are "low", "normal", "medium" and "high".  Defaults to "normal".  The "high" level requires the CPU and
+	// memory of the VirtualMachine to be fully reserved.
+	// +optional
+	LatencySensitivity VirtualMachineLatencySensitivity `json:"latencySensitivity,omitempty"`
+
+	// CpuShares describes the relative priority of the VirtualMachine for CPU when there is contention.
+	// +optional
+	CpuShares *VirtualMachineSharesSpec `json:"cpuShares,omitempty"`
+
+	// MemoryShares describes the relative priority of the VirtualMachine for memory when there is contention.
+	// +optional
+	MemoryShares *VirtualMachineSharesSpec `json:"memoryShares,omitempty"`
+}
+
+// VirtualMachineNUMASpec describes the NUMA topology of a VirtualMachine.
+type VirtualMachineNUMASpec struct {
+	// NodeAffinity lists the host NUMA nodes the VirtualMachine may be scheduled on.  When empty, the VirtualMachine
+	// may be scheduled on any NUMA node.
+	// +optional
+	NodeAffinity []int32 `json:"nodeAffinity,omitempty"`
+
+	// ExposeVirtualNUMA specifies whether the NUMA topology of the host is exposed to the guest as virtual NUMA
+	// nodes.
+	// +optional
+	ExposeVirtualNUMA bool `json:"exposeVirtualNUMA,omitempty"`
+
+	// CoresPerNUMANode is the number of virtual CPUs per virtual NUMA node.  Cpus must be a multiple of
+	// CoresPerNUMANode.  When unset, the size of the virtual NUMA nodes is derived from the host.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	CoresPerNUMANode int32 `json:"coresPerNUMANode,omitempty"`
+}
+
+// VirtualMachineHyperthreadSharing describes how the virtual CPUs of a VirtualMachine may share physical cores.
+// +kubebuilder:validation:Enum=any;none;internal
+type VirtualMachineHyperthreadSharing string
+
+// See govmomi.vim25.types.VirtualMachineHtSharing
+const (
+	// HyperthreadSharingAny allows the virtual CPUs to share cores with the virtual CPUs of any VirtualMachine.
+	HyperthreadSharingAny VirtualMachineHyperthreadSharing = "any"
+
+	// HyperthreadSharingNone prevents the virtual CPUs from sharing cores with any other virtual CPU.
+	HyperthreadSharingNone VirtualMachineHyperthreadSharing = "none"
+
+	// HyperthreadSharingInternal allows the virtual CPUs to share cores with the other virtual CPUs of the same
+	// VirtualMachine only.
+	HyperthreadSharingInternal VirtualMachineHyperthreadSharing = "internal"
+)
+
+// VirtualMachineLatencySensitivity describes the latency sensitivity of a VirtualMachine.
+// +kubebuilder:validation:Enum=low;normal;medium;high
+type VirtualMachineLatencySensitivity string
+
+// See govmomi.vim25.types.LatencySensitivitySensitivityLevel
+const (
+	LatencySensitivityLow    VirtualMachineLatencySensitivity = "low"
+	LatencySensitivityNormal VirtualMachineLatencySensitivity = "normal"
+	LatencySensitivityMedium VirtualMachineLatencySensitivity = "medium"
+	LatencySensitivityHigh   VirtualMachineLatencySensitivity = "high"
+)
+
+// VirtualMachineSharesLevel is the level of the shares of a VirtualMachine.
+// +kubebuilder:validation:Enum=low;normal;high;custom
+type VirtualMachineSharesLevel string
+
+// See govmomi.vim25.types.SharesLevel
+const (
+	SharesLevelLow    VirtualMachineSharesLevel = "low"
+	SharesLevelNormal VirtualMachineSharesLevel = "normal"
+	SharesLevelHigh   VirtualMachineSharesLevel = "high"
+	SharesLevelCustom VirtualMachineSharesLevel = "custom"
+)
+
+// VirtualMachineSharesSpec describes the shares of a VirtualMachine for a resource.
+type VirtualMachineSharesSpec struct {
+	// Level is the level of the shares.  Valid values are "low", "normal", "high" and "custom".
+	Level VirtualMachineSharesLevel `json:"level"`
+
+	// Shares is the number of shares, when Level is "custom".
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	Shares int32 `json:"shares,omitempty"`
 }
 
 // VirtualMachineResourceSpec describes a virtual hardware policy specification.
 type VirtualMachineResourceSpec struct {
-	Cpu    resource.Quantity `json:"cpu,omitempty"`
+	// Cpu is expressed in cores, as for a container, and converted to MHz with the clock speed of the host the
+	// VirtualMachine is placed on.  It may not exceed the Cpus of the VirtualMachineClassHardware.
+	Cpu resource.Quantity `json:"cpu,omitempty"`
+
+	// Memory may not exceed the Memory of the VirtualMachineClassHardware.
 	Memory resource.Quantity `json:"memory,omitempty"`
 }
 
@@ -84,9 +203,40 @@ type VirtualMachineClassResources struct {
 	Limits   VirtualMachineResourceSpec `json:"limits,omitempty"`
 }
 
+// VirtualMachineClassDevicePolicy describes the devices a VirtualMachine that uses a VirtualMachineClass may
+// request in addition to the devices of the VirtualMachineClass.
+type VirtualMachineClassDevicePolicy struct {
+	// AllowedVGPUProfileNames lists the vGPU profiles that may be requested.
+	// +optional
+	AllowedVGPUProfileNames []string `json:"allowedVGPUProfileNames,omitempty"`
+
+	// AllowedDynamicDirectPathIODevices lists the Dynamic DirectPath I/O devices that may be requested.  Only the
+	// VendorID and DeviceID of each entry are considered.
+	// +optional
+	AllowedDynamicDirectPathIODevices []DynamicDirectPathIODevice `json:"allowedDynamicDirectPathIODevices,omitempty"`
+
+	// MaxVGPUDevices is the maximum number of vGPU devices a VirtualMachine may request.  It must be specified when
+	// AllowedVGPUProfileNames is not empty, since a VirtualMachine may not request any vGPU device when it is unset.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	MaxVGPUDevices int32 `json:"maxVGPUDevices,omitempty"`
+
+	// MaxDynamicDirectPathIODevices is the maximum number of Dynamic DirectPath I/O devices a VirtualMachine may
+	// request.  It must be specified when AllowedDynamicDirectPathIODevices is not empty, since a VirtualMachine may
+	// not request any Dynamic DirectPath I/O device when it is unset.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	MaxDynamicDirectPathIODevices int32 `json:"maxDynamicDirectPathIODevices,omitempty"`
+}
+
 // VirtualMachineClassPolicies describes the policy configuration to be used by a VirtualMachineClass.
 type VirtualMachineClassPolicies struct {
 	Resources VirtualMachineClassResources `json:"resources,omitempty"`
+
+	// Devices describes the devices a VirtualMachine may request in addition to the devices of the
+	// VirtualMachineClass.  When unset, a VirtualMachine may not request additional devices.
+	// +optional
+	Devices *VirtualMachineClassDevicePolicy `json:"devices,omitempty"`
 }
 
 // VirtualMachineClassSpec defines the desired state of VirtualMachineClass
@@ -126,6 +276,11 @@ type VirtualMachineClassStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="VGPUDevicesProfileNames",type="string",priority=1,JSONPath=".spec.hardware.devices.vgpuDevices[*].profileName"
 // +kubebuilder:printcolumn:name="PassthroughDeviceIDs",type="string",priority=1,JSONPath=".spec.hardware.devices.dynamicDirectPathIODevices[*].deviceID"
+// +kubebuilder:printcolumn:name="CoresPerSocket",type="integer",priority=1,JSONPath=".spec.hardware.coresPerSocket"
+// +kubebuilder:printcolumn:name="HyperthreadSharing",type="string",priority=1,JSONPath=".spec.hardware.hyperthreadSharing"
+// +kubebuilder:printcolumn:name="NUMANodeAffinity",type="string",priority=1,JSONPath=".spec.hardware.numa.nodeAffinity"
+// +kubebuilder:printcolumn:name="CoresPerNUMANode",type="integer",priority=1,JSONPath=".spec.hardware.numa.coresPerNUMANode"
+// +kubebuilder:printcolumn:name="LatencySensitivity",type="string",priority=1,JSONPath=".spec.hardware.latencySensitivity"
 
 // VirtualMachineClass is the Schema for the virtualmachineclasses API.
 // A VirtualMachineClass represents the desired specification and the observed status of a VirtualMachineClass
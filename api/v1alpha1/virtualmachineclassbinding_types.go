@@ -17,18 +17,55 @@ type ClassReference struct {
 	Name string `json:"name"`
 }
 
+// VirtualMachineClassBindingStatus defines the observed state of a VirtualMachineClassBinding.
+type VirtualMachineClassBindingStatus struct {
+	// ClassNames lists the names of the existing VirtualMachineClasses bound to the namespace by the
+	// VirtualMachineClassBinding.
+	// +optional
+	ClassNames []string `json:"classNames,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineClassBinding.  The ClassesFound
+	// condition reports whether the referenced VirtualMachineClass exists, or whether the ClassSelector selects any
+	// VirtualMachineClass.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (vmClassBinding *VirtualMachineClassBinding) GetConditions() Conditions {
+	return vmClassBinding.Status.Conditions
+}
+
+func (vmClassBinding *VirtualMachineClassBinding) SetConditions(conditions Conditions) {
+	vmClassBinding.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmclassbinding
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Class",type="string",JSONPath=".classRef.name"
+// +kubebuilder:printcolumn:name="BoundClasses",type="string",priority=1,JSONPath=".status.classNames"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // VirtualMachineClassBinding is a binding object responsible for
-// defining a VirtualMachineClass and a Namespace associated with it
+// defining a VirtualMachineClass and a Namespace associated with it.
+// A VirtualMachineClassBinding binds either the single VirtualMachineClass named by its ClassRef, or all of the
+// VirtualMachineClasses whose labels match its ClassSelector.  Exactly one of ClassRef.Name and ClassSelector must
+// be specified.
 type VirtualMachineClassBinding struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	// ClassReference is a reference to a VirtualMachineClass object
+	// +optional
 	ClassRef ClassReference `json:"classRef,omitempty"`
+
+	// ClassSelector selects the VirtualMachineClasses bound to the namespace by their labels.  Classes created after
+	// the VirtualMachineClassBinding are bound as soon as their labels match.  The selector may not be empty: each
+	// class must be selected by its labels.
+	// +optional
+	ClassSelector *metav1.LabelSelector `json:"classSelector,omitempty"`
+
+	Status VirtualMachineClassBindingStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
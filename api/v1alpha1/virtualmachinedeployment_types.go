@@ -0,0 +1,217 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// VirtualMachineDeploymentRevisionAnnotation is the revision annotation of a VirtualMachineDeployment's
+// VirtualMachineReplicaSets, which records its rollout sequence.
+const VirtualMachineDeploymentRevisionAnnotation = GroupName + "/revision"
+
+// VirtualMachineDeploymentStrategyType describes how VirtualMachines are replaced when the template of a
+// VirtualMachineDeployment changes.
+// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+type VirtualMachineDeploymentStrategyType string
+
+const (
+	// RecreateVirtualMachineDeploymentStrategyType deletes all existing VirtualMachines before creating new ones.
+	RecreateVirtualMachineDeploymentStrategyType VirtualMachineDeploymentStrategyType = "Recreate"
+
+	// RollingUpdateVirtualMachineDeploymentStrategyType gradually replaces the VirtualMachines of the old
+	// VirtualMachineReplicaSets with the VirtualMachines of the new one.
+	RollingUpdateVirtualMachineDeploymentStrategyType VirtualMachineDeploymentStrategyType = "RollingUpdate"
+)
+
+// RollingUpdateVirtualMachineDeployment controls the desired behavior of a rolling update.  A VirtualMachine is
+// counted as available once its ReadinessProbe has succeeded for at least MinReadySeconds; a VirtualMachine
+// without a ReadinessProbe is counted as available once it is powered on.
+type RollingUpdateVirtualMachineDeployment struct {
+	// MaxUnavailable is the maximum number of VirtualMachines that can be unavailable during the update.  Value can
+	// be an absolute number (ex: 5) or a percentage of desired VirtualMachines (ex: 10%).  Absolute number is
+	// calculated from percentage by rounding down.  This can not be 0 if MaxSurge is 0.  Defaults to 25%.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the maximum number of VirtualMachines that can be created over the desired number of
+	// VirtualMachines.  Value can be an absolute number (ex: 5) or a percentage of desired VirtualMachines
+	// (ex: 10%).  Absolute number is calculated from percentage by rounding up.  This can not be 0 if
+	// MaxUnavailable is 0.  Defaults to 25%.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// VirtualMachineDeploymentStrategy describes how to replace existing VirtualMachines with new ones.
+type VirtualMachineDeploymentStrategy struct {
+	// Type of deployment.  Can be "Recreate" or "RollingUpdate".  Defaults to "RollingUpdate".
+	// +optional
+	Type VirtualMachineDeploymentStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate describes the rolling update config parameters.  Present only if Type is "RollingUpdate".
+	// +optional
+	RollingUpdate *RollingUpdateVirtualMachineDeployment `json:"rollingUpdate,omitempty"`
+}
+
+// VirtualMachineDeploymentRollback describes the revision a VirtualMachineDeployment is rolled back to.
+type VirtualMachineDeploymentRollback struct {
+	// Revision is the revision to roll back to.  If set to 0, the VirtualMachineDeployment is rolled back to the
+	// last revision.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// VirtualMachineDeploymentSpec defines the desired state of a VirtualMachineDeployment.
+type VirtualMachineDeploymentSpec struct {
+	// Replicas is the number of desired VirtualMachines.  This is a pointer to distinguish between explicit zero
+	// and unspecified.  Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum:=0
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Selector is a label query over the VirtualMachines that are managed by this VirtualMachineDeployment.  It
+	// must match the labels of Template.
+	Selector *metav1.LabelSelector `json:"selector"`
+
+	// Template describes the VirtualMachines that will be created.  Changing the template, such as its ImageName
+	// or ClassName, triggers a new rollout.
+	Template VirtualMachineTemplateSpec `json:"template"`
+
+	// Strategy describes how to replace existing VirtualMachines with new ones.
+	// +optional
+	Strategy VirtualMachineDeploymentStrategy `json:"strategy,omitempty"`
+
+	// MinReadySeconds is the minimum number of seconds for which a newly created VirtualMachine should be ready,
+	// as determined by its ReadinessProbe, without any of its probes failing, for it to be considered available.
+	// Defaults to 0, i.e. the VirtualMachine is considered available as soon as it is ready.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// RevisionHistoryLimit is the number of old VirtualMachineReplicaSets to retain to allow rollback.  Defaults
+	// to 10.
+	// +optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum:=0
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Paused indicates that the VirtualMachineDeployment is paused.  Changes to the template of a paused
+	// VirtualMachineDeployment do not trigger a new rollout until it is resumed.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// RollbackTo describes the revision the VirtualMachineDeployment is rolled back to.  It is cleared by the
+	// VirtualMachineDeployment controller once the rollback has been performed.
+	// +optional
+	RollbackTo *VirtualMachineDeploymentRollback `json:"rollbackTo,omitempty"`
+
+	// ProgressDeadlineSeconds is the maximum time in seconds for a VirtualMachineDeployment to make progress before
+	// it is considered to be failed.  The VirtualMachineDeployment controller will continue to process failed
+	// VirtualMachineDeployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the
+	// status.  This must be greater than MinReadySeconds.  Defaults to 600s.
+	// +optional
+	// +kubebuilder:default=600
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+}
+
+// VirtualMachineDeploymentStatus defines the observed state of a VirtualMachineDeployment.
+type VirtualMachineDeploymentStatus struct {
+	// ObservedGeneration is the most recent generation observed by the VirtualMachineDeployment controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Replicas is the total number of non-terminated VirtualMachines targeted by this VirtualMachineDeployment.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// UpdatedReplicas is the total number of non-terminated VirtualMachines targeted by this
+	// VirtualMachineDeployment that have the desired template.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// ReadyReplicas is the number of VirtualMachines targeted by this VirtualMachineDeployment whose
+	// ReadinessProbe is succeeding.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// AvailableReplicas is the total number of VirtualMachines targeted by this VirtualMachineDeployment that have
+	// been ready for at least MinReadySeconds.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// UnavailableReplicas is the total number of unavailable VirtualMachines targeted by this
+	// VirtualMachineDeployment.  This is the total number of VirtualMachines that are still required for the
+	// VirtualMachineDeployment to have 100% available capacity.
+	// +optional
+	UnavailableReplicas int32 `json:"unavailableReplicas,omitempty"`
+
+	// CurrentRevision is the revision of the VirtualMachineReplicaSet matching the current template.
+	// +optional
+	CurrentRevision int64 `json:"currentRevision,omitempty"`
+
+	// CollisionCount is the count of hash collisions for the VirtualMachineDeployment.  The controller uses this
+	// field as a collision avoidance mechanism when it needs to create the name for the newest
+	// VirtualMachineReplicaSet.
+	// +optional
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+
+	// Selector is the string form of the label selector in the spec, used by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineDeployment.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (d *VirtualMachineDeployment) GetConditions() Conditions {
+	return d.Status.Conditions
+}
+
+func (d *VirtualMachineDeployment) SetConditions(conditions Conditions) {
+	d.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmdeploy
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas"
+// +kubebuilder:printcolumn:name="Up-To-Date",type="integer",JSONPath=".status.updatedReplicas"
+// +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.availableReplicas"
+// +kubebuilder:printcolumn:name="Revision",type="integer",priority=1,JSONPath=".status.currentRevision"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineDeployment is the Schema for the virtualmachinedeployments API.
+// A VirtualMachineDeployment provides declarative updates for VirtualMachineReplicaSets, and thus for the
+// VirtualMachines created from its Template, such as rolling out a new VirtualMachineImage or
+// VirtualMachineClass.
+type VirtualMachineDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineDeploymentSpec   `json:"spec,omitempty"`
+	Status VirtualMachineDeploymentStatus `json:"status,omitempty"`
+}
+
+func (d *VirtualMachineDeployment) NamespacedName() string {
+	return d.Namespace + "/" + d.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineDeploymentList contains a list of VirtualMachineDeployment.
+type VirtualMachineDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineDeployment `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineDeployment{}, &VirtualMachineDeploymentList{})
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImportedFromAnnotation is the annotation applied to a VirtualMachine produced by a VirtualMachineImport.  Its
+// value is the name of the VirtualMachineImport, in the same namespace, that produced the VirtualMachine.
+const ImportedFromAnnotation = GroupName + "/imported-from"
+
+// ImportPhase indicates the phase of a VirtualMachineImport.
+type ImportPhase string
+
+const (
+	// ImportPending phase indicates that the import request has been accepted, but the source vSphere VM has not
+	// been located yet.
+	ImportPending ImportPhase = "Pending"
+
+	// ImportAnalyzing phase indicates that the configuration of the source vSphere VM is being mapped onto a
+	// VirtualMachineSpec.
+	ImportAnalyzing ImportPhase = "Analyzing"
+
+	// ImportImporting phase indicates that the VirtualMachine is being created and the source vSphere VM is being
+	// placed under management.
+	ImportImporting ImportPhase = "Importing"
+
+	// ImportImported phase indicates that the source vSphere VM is managed by the produced VirtualMachine.
+	ImportImported ImportPhase = "Imported"
+
+	// ImportFailed phase indicates that the import failed.  The conditions describe the failure.
+	ImportFailed ImportPhase = "Failed"
+)
+
+// VirtualMachineImportSource identifies an existing vSphere VM that is imported.  Exactly one of InstanceUUID and
+// BiosUUID must be specified.
+type VirtualMachineImportSource struct {
+	// InstanceUUID is the vSphere instance UUID of the VM to import.
+	// +optional
+	InstanceUUID string `json:"instanceUUID,omitempty"`
+
+	// BiosUUID is the BIOS UUID of the VM to import.
+	// +optional
+	BiosUUID string `json:"biosUUID,omitempty"`
+}
+
+// VirtualMachineImportSpec defines the desired state of a VirtualMachineImport.
+type VirtualMachineImportSpec struct {
+	// Source identifies the existing vSphere VM that is imported.
+	Source VirtualMachineImportSource `json:"source"`
+
+	// VirtualMachineName is the name of the VirtualMachine, in the same namespace as the VirtualMachineImport,
+	// that is produced.  Defaults to the name of the VirtualMachineImport.
+	// +optional
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+
+	// ClassName is the name of the VirtualMachineClass the produced VirtualMachine references.  When empty, the
+	// VirtualMachineClass available to the namespace that matches the hardware of the source VM is used.
+	// +optional
+	ClassName string `json:"className,omitempty"`
+
+	// ImageName is the name of the VirtualMachineImage the produced VirtualMachine references.  An imported VM was
+	// not necessarily deployed from a VirtualMachineImage, so this is used for bookkeeping only.
+	// +optional
+	ImageName string `json:"imageName,omitempty"`
+
+	// StorageClass is the name of the StorageClass the produced VirtualMachine references.  When empty, the
+	// StorageClass matching the storage policy of the source VM is used.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// DryRun indicates that the source VM should only be analyzed.  The inferred spec and the mapping report are
+	// surfaced in the status, but no VirtualMachine is produced.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// VirtualMachineImportUnsupportedSetting describes a setting of the source vSphere VM that cannot be represented
+// by a VirtualMachineSpec.  The setting is preserved on the VM, but is not managed by VM Operator.
+type VirtualMachineImportUnsupportedSetting struct {
+	// Path identifies the setting in the vim.vm.ConfigInfo of the source VM, e.g. "hardware.device[4000]".
+	Path string `json:"path"`
+
+	// Value is a human readable representation of the value of the setting.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Reason is a human readable message describing why the setting is not supported.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// VirtualMachineImportStatus defines the observed state of a VirtualMachineImport.
+type VirtualMachineImportStatus struct {
+	// Phase indicates the phase of the VirtualMachineImport.
+	// +optional
+	Phase ImportPhase `json:"phase,omitempty"`
+
+	// InstanceUUID is the vSphere instance UUID of the located source VM.
+	// +optional
+	InstanceUUID string `json:"instanceUUID,omitempty"`
+
+	// VirtualMachineName is the name of the produced VirtualMachine.
+	// +optional
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+
+	// InferredSpec is the VirtualMachineSpec inferred from the configuration of the source VM.
+	// +optional
+	InferredSpec *VirtualMachineSpec `json:"inferredSpec,omitempty"`
+
+	// UnsupportedSettings lists the settings of the source VM that cannot be represented by the InferredSpec.
+	// +optional
+	UnsupportedSettings []VirtualMachineImportUnsupportedSetting `json:"unsupportedSettings,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineImport.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (vmImport *VirtualMachineImport) GetConditions() Conditions {
+	return vmImport.Status.Conditions
+}
+
+func (vmImport *VirtualMachineImport) SetConditions(conditions Conditions) {
+	vmImport.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmimport
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",JSONPath=".status.virtualMachineName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="InstanceUUID",type="string",priority=1,JSONPath=".status.instanceUUID"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineImport is the Schema for the virtualmachineimports API.
+// A VirtualMachineImport places an existing vSphere VM, created outside of VM Operator, under management by
+// producing a VirtualMachine whose spec is inferred from the configuration of the VM.
+type VirtualMachineImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineImportSpec   `json:"spec,omitempty"`
+	Status VirtualMachineImportStatus `json:"status,omitempty"`
+}
+
+func (vmImport *VirtualMachineImport) NamespacedName() string {
+	return vmImport.Namespace + "/" + vmImport.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineImportList contains a list of VirtualMachineImport.
+type VirtualMachineImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineImport `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineImport{}, &VirtualMachineImportList{})
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineMemoryMetrics describes the memory usage of a VirtualMachine.
+type VirtualMachineMemoryMetrics struct {
+	// Active is the amount of memory actively used by the guest, as estimated by the hypervisor.
+	// +optional
+	Active resource.Quantity `json:"active,omitempty"`
+
+	// Consumed is the amount of host memory consumed by the VirtualMachine.
+	// +optional
+	Consumed resource.Quantity `json:"consumed,omitempty"`
+}
+
+// VirtualMachineDiskMetrics describes the usage of a virtual disk of a VirtualMachine.
+type VirtualMachineDiskMetrics struct {
+	// Name is the name of the VirtualMachineVolume the disk belongs to.  It is empty for the boot disk of the
+	// VirtualMachine, which is not described by a VirtualMachineVolume.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ReadIOPS is the average number of read operations per second.
+	// +optional
+	ReadIOPS int64 `json:"readIOPS,omitempty"`
+
+	// WriteIOPS is the average number of write operations per second.
+	// +optional
+	WriteIOPS int64 `json:"writeIOPS,omitempty"`
+
+	// ReadThroughput is the average number of bytes read per second.
+	// +optional
+	ReadThroughput resource.Quantity `json:"readThroughput,omitempty"`
+
+	// WriteThroughput is the average number of bytes written per second.
+	// +optional
+	WriteThroughput resource.Quantity `json:"writeThroughput,omitempty"`
+}
+
+// VirtualMachineNetworkInterfaceMetrics describes the usage of a network interface of a VirtualMachine.
+type VirtualMachineNetworkInterfaceMetrics struct {
+	// Name is the name of the network the interface is attached to, as in the VirtualMachineNetworkInterface.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// MacAddress is the MAC address of the network interface.
+	// +optional
+	MacAddress string `json:"macAddress,omitempty"`
+
+	// ReceivedBytes is the number of bytes received by the interface during the Window.
+	// +optional
+	ReceivedBytes resource.Quantity `json:"receivedBytes,omitempty"`
+
+	// TransmittedBytes is the number of bytes transmitted by the interface during the Window.
+	// +optional
+	TransmittedBytes resource.Quantity `json:"transmittedBytes,omitempty"`
+}
+
+// +genclient
+// +genclient:readonly
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmmetrics
+// +kubebuilder:printcolumn:name="CPU",type="string",JSONPath=".usage.cpu"
+// +kubebuilder:printcolumn:name="Memory",type="string",JSONPath=".usage.memory"
+// +kubebuilder:printcolumn:name="Window",type="string",JSONPath=".window"
+
+// VirtualMachineMetrics is the Schema for the virtualmachinemetrics API.
+// A VirtualMachineMetrics describes the resource usage of the VirtualMachine with the same name and namespace,
+// sampled over a time window.  Its shape mirrors the metrics.k8s.io PodMetrics, with the CPU and memory usage
+// reported in Usage, so that tooling written for PodMetrics is easily adapted to it.  It is not served by the
+// metrics.k8s.io API, so it is not consumed by kubectl top or the HorizontalPodAutoscaler.
+// VirtualMachineMetrics are read-only: they are produced by VM Operator, and are not meant to be modified by users.
+type VirtualMachineMetrics struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Timestamp is the time at which the metrics were collected.  The metrics are averaged over the interval
+	// [Timestamp-Window, Timestamp].
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// Window is the length of the interval over which the metrics were sampled.
+	Window metav1.Duration `json:"window"`
+
+	// Usage is the CPU usage, in millicores, and the consumed memory of the VirtualMachine, keyed by
+	// corev1.ResourceCPU and corev1.ResourceMemory, as in the metrics.k8s.io ContainerMetrics.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty"`
+
+	// Memory describes the memory usage of the VirtualMachine.
+	// +optional
+	Memory VirtualMachineMemoryMetrics `json:"memory,omitempty"`
+
+	// Disks describes the usage of each virtual disk of the VirtualMachine.
+	// +optional
+	Disks []VirtualMachineDiskMetrics `json:"disks,omitempty"`
+
+	// NetworkInterfaces describes the usage of each network interface of the VirtualMachine.
+	// +optional
+	NetworkInterfaces []VirtualMachineNetworkInterfaceMetrics `json:"networkInterfaces,omitempty"`
+
+	// Uptime is the time elapsed since the VirtualMachine was last powered on.
+	// +optional
+	Uptime metav1.Duration `json:"uptime,omitempty"`
+}
+
+func (metrics *VirtualMachineMetrics) NamespacedName() string {
+	return metrics.Namespace + "/" + metrics.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineMetricsList contains a list of VirtualMachineMetrics.
+type VirtualMachineMetricsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineMetrics `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineMetrics{}, &VirtualMachineMetricsList{})
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MigrationPhase indicates the phase of a VirtualMachineMigration.
+type MigrationPhase string
+
+const (
+	// MigrationPending phase indicates that the migration has been accepted, but has not started yet.
+	MigrationPending MigrationPhase = "Pending"
+
+	// MigrationRunning phase indicates that the VirtualMachine is being migrated.
+	MigrationRunning MigrationPhase = "Running"
+
+	// MigrationSucceeded phase indicates that the VirtualMachine has been migrated to the target.
+	MigrationSucceeded MigrationPhase = "Succeeded"
+
+	// MigrationFailed phase indicates that the migration failed.  The conditions describe the failure.
+	MigrationFailed MigrationPhase = "Failed"
+)
+
+// MigrationPriority describes the priority of a migration relative to other migrations.
+// +kubebuilder:validation:Enum=High;Default;Low
+type MigrationPriority string
+
+// See govmomi.vim25.types.VirtualMachineMovePriority
+const (
+	// HighMigrationPriority allocates more resources to the migration, so that it completes as fast as possible.
+	HighMigrationPriority MigrationPriority = "High"
+
+	// DefaultMigrationPriority allocates the default amount of resources to the migration.
+	DefaultMigrationPriority MigrationPriority = "Default"
+
+	// LowMigrationPriority allocates fewer resources to the migration, so that it has a lower impact on other
+	// workloads, at the cost of taking longer to complete.
+	LowMigrationPriority MigrationPriority = "Low"
+)
+
+// VirtualMachineMigrationSpec defines the desired state of a VirtualMachineMigration.  At least one of
+// TargetZone, TargetHostGroup and TargetStorageClass must be specified.
+type VirtualMachineMigrationSpec struct {
+	// VirtualMachineName is the name of the VirtualMachine, in the same namespace as the VirtualMachineMigration,
+	// that is migrated.
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// TargetZone is the name of the AvailabilityZone the VirtualMachine is migrated to.
+	// +optional
+	TargetZone string `json:"targetZone,omitempty"`
+
+	// TargetHostGroup is the name of the vSphere host group the VirtualMachine is migrated to.
+	// +optional
+	TargetHostGroup string `json:"targetHostGroup,omitempty"`
+
+	// TargetStorageClass is the name of the StorageClass the VirtualMachine's disks are migrated to.  Upon
+	// successful migration, the VirtualMachine's spec.storageClass is updated to this value.
+	// +optional
+	TargetStorageClass string `json:"targetStorageClass,omitempty"`
+
+	// Priority describes the priority of the migration.  Valid values are "High", "Default" and "Low".
+	// Defaults to "Default".
+	// +optional
+	Priority MigrationPriority `json:"priority,omitempty"`
+}
+
+// VirtualMachineMigrationStatus defines the observed state of a VirtualMachineMigration.
+type VirtualMachineMigrationStatus struct {
+	// Phase indicates the phase of the VirtualMachineMigration.
+	// +optional
+	Phase MigrationPhase `json:"phase,omitempty"`
+
+	// Progress is the progress of the migration, as a percentage.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=100
+	Progress int32 `json:"progress,omitempty"`
+
+	// SourceZone is the AvailabilityZone the VirtualMachine was placed in when the migration started.
+	// +optional
+	SourceZone string `json:"sourceZone,omitempty"`
+
+	// SourceHost is the host the VirtualMachine was executing on when the migration started.
+	// +optional
+	SourceHost string `json:"sourceHost,omitempty"`
+
+	// StartTime is the time at which the migration was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time at which the migration completed, successfully or not.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineMigration.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (migration *VirtualMachineMigration) GetConditions() Conditions {
+	return migration.Status.Conditions
+}
+
+func (migration *VirtualMachineMigration) SetConditions(conditions Conditions) {
+	migration.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmmigration
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",JSONPath=".spec.virtualMachineName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.progress"
+// +kubebuilder:printcolumn:name="TargetZone",type="string",priority=1,JSONPath=".spec.targetZone"
+// +kubebuilder:printcolumn:name="TargetStorageClass",type="string",priority=1,JSONPath=".spec.targetStorageClass"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineMigration is the Schema for the virtualmachinemigrations API.
+// A VirtualMachineMigration requests that a VirtualMachine is moved to another zone, host group or storage class.
+type VirtualMachineMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineMigrationSpec   `json:"spec,omitempty"`
+	Status VirtualMachineMigrationStatus `json:"status,omitempty"`
+}
+
+func (migration *VirtualMachineMigration) NamespacedName() string {
+	return migration.Namespace + "/" + migration.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineMigrationList contains a list of VirtualMachineMigration.
+type VirtualMachineMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineMigration `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineMigration{}, &VirtualMachineMigrationList{})
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PublishPhase indicates the phase of a VirtualMachinePublishRequest.
+type PublishPhase string
+
+const (
+	// PublishPending phase indicates that the publish request has been accepted, but the source VirtualMachine or
+	// the target content library have not been validated yet.
+	PublishPending PublishPhase = "Pending"
+
+	// PublishUploading phase indicates that the source VirtualMachine is being captured as an OVF and uploaded to
+	// the target content library item.
+	PublishUploading PublishPhase = "Uploading"
+
+	// PublishUploaded phase indicates that the OVF has been uploaded to the target content library item.
+	PublishUploaded PublishPhase = "Uploaded"
+
+	// PublishFailed phase indicates that the publish request failed.  The conditions describe the failure.
+	PublishFailed PublishPhase = "Failed"
+)
+
+// VirtualMachinePublishRequestSource describes the VirtualMachine that is published.
+type VirtualMachinePublishRequestSource struct {
+	// Name is the name of the VirtualMachine, in the same namespace as the VirtualMachinePublishRequest, that is
+	// published.  Defaults to the name of the VirtualMachinePublishRequest.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// VirtualMachinePublishRequestTarget describes the content library item the VirtualMachine is published to.
+type VirtualMachinePublishRequestTarget struct {
+	// LibraryName is the name of the ContentLibrary, in the same namespace as the VirtualMachinePublishRequest,
+	// that the library item is created in.
+	// +required
+	LibraryName string `json:"libraryName"`
+
+	// LibraryItem defines the desired state of the content library item created from the VirtualMachine.  When
+	// Overwrite is false and an item with the same name already exists in the library, the publish request fails.
+	// +required
+	LibraryItem LibraryItem `json:"libraryItem"`
+}
+
+// VirtualMachinePublishRequestSpec defines the desired state of a VirtualMachinePublishRequest.
+type VirtualMachinePublishRequestSpec struct {
+	// Source describes the VirtualMachine that is published.
+	// +optional
+	Source VirtualMachinePublishRequestSource `json:"source,omitempty"`
+
+	// Target describes the content library item the VirtualMachine is published to.
+	// +required
+	Target VirtualMachinePublishRequestTarget `json:"target"`
+}
+
+// VirtualMachinePublishRequestStatus defines the observed state of a VirtualMachinePublishRequest.
+type VirtualMachinePublishRequestStatus struct {
+	// SourceRef is the reference to the source VirtualMachine, as resolved when the request was accepted.
+	// +optional
+	SourceRef *VirtualMachinePublishRequestSource `json:"sourceRef,omitempty"`
+
+	// Phase indicates the phase of the VirtualMachinePublishRequest.
+	// +optional
+	Phase PublishPhase `json:"phase,omitempty"`
+
+	// StartTime is the time at which the upload of the VirtualMachine was started.
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time at which the VirtualMachinePublishRequest completed, successfully or not.
+	// +optional
+	CompletionTime metav1.Time `json:"completionTime,omitempty"`
+
+	// Attempts is the number of times the VirtualMachine publish has been attempted.
+	// +optional
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// ItemUUID is the identifier which uniquely identifies the published library item in vCenter.
+	// +optional
+	ItemUUID string `json:"itemUUID,omitempty"`
+
+	// ImageName is the name of the VirtualMachineImage that corresponds to the published library item, once it
+	// is available.
+	// +optional
+	ImageName string `json:"imageName,omitempty"`
+
+	// Ready denotes that the library item has been published and is ready to be used.
+	Ready bool `json:"ready"`
+
+	// Conditions describes the current condition information of the VirtualMachinePublishRequest.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (publishRequest *VirtualMachinePublishRequest) GetConditions() Conditions {
+	return publishRequest.Status.Conditions
+}
+
+func (publishRequest *VirtualMachinePublishRequest) SetConditions(conditions Conditions) {
+	publishRequest.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmpub
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".status.sourceRef.name"
+// +kubebuilder:printcolumn:name="LibraryName",type="string",JSONPath=".spec.target.libraryName"
+// +kubebuilder:printcolumn:name="ItemName",type="string",JSONPath=".spec.target.libraryItem.name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="ItemUUID",type="string",priority=1,JSONPath=".status.itemUUID"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready"
+
+// VirtualMachinePublishRequest is the schema for the VirtualMachine publish request API.
+// A VirtualMachinePublishRequest captures a VirtualMachine as an OVF and publishes it as an item in a content
+// library, from which new VirtualMachineImages can be created.
+type VirtualMachinePublishRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachinePublishRequestSpec   `json:"spec,omitempty"`
+	Status VirtualMachinePublishRequestStatus `json:"status,omitempty"`
+}
+
+func (publishRequest *VirtualMachinePublishRequest) NamespacedName() string {
+	return publishRequest.Namespace + "/" + publishRequest.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachinePublishRequestList contains a list of VirtualMachinePublishRequest.
+type VirtualMachinePublishRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachinePublishRequest `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachinePublishRequest{}, &VirtualMachinePublishRequestList{})
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Resource names tracked by a VirtualMachineQuota.
+const (
+	// VirtualMachineQuotaVirtualMachines is the number of VirtualMachines.
+	VirtualMachineQuotaVirtualMachines corev1.ResourceName = "virtualmachines"
+
+	// VirtualMachineQuotaCPUs is the number of virtual CPUs, as specified by the VirtualMachineClass hardware.
+	VirtualMachineQuotaCPUs corev1.ResourceName = "cpus"
+
+	// VirtualMachineQuotaMemory is the size of the memory, as specified by the VirtualMachineClass hardware.
+	VirtualMachineQuotaMemory corev1.ResourceName = "memory"
+
+	// VirtualMachineQuotaRequestsCPU is the CPU reservation, as specified by the VirtualMachineClass policies.
+	VirtualMachineQuotaRequestsCPU corev1.ResourceName = "requests.cpu"
+
+	// VirtualMachineQuotaLimitsCPU is the CPU limit, as specified by the VirtualMachineClass policies.  A
+	// VirtualMachine without a CPU limit is accounted for with its number of virtual CPUs.
+	VirtualMachineQuotaLimitsCPU corev1.ResourceName = "limits.cpu"
+
+	// VirtualMachineQuotaRequestsMemory is the memory reservation, as specified by the VirtualMachineClass policies.
+	VirtualMachineQuotaRequestsMemory corev1.ResourceName = "requests.memory"
+
+	// VirtualMachineQuotaLimitsMemory is the memory limit, as specified by the VirtualMachineClass policies.  A
+	// VirtualMachine without a memory limit is accounted for with the size of its memory.
+	VirtualMachineQuotaLimitsMemory corev1.ResourceName = "limits.memory"
+
+	// VirtualMachineQuotaVGPUs is the number of vGPU devices, of any profile.
+	VirtualMachineQuotaVGPUs corev1.ResourceName = "vgpus"
+
+	// VirtualMachineQuotaVGPUProfilePrefix is the prefix of the resource names of the number of vGPU devices of a
+	// given profile, e.g. "vgpu.vmoperator.vmware.com/grid_v100-4q".
+	VirtualMachineQuotaVGPUProfilePrefix = "vgpu." + GroupName + "/"
+
+	// VirtualMachineQuotaDynamicDirectPathIODevices is the number of Dynamic DirectPath I/O devices.
+	VirtualMachineQuotaDynamicDirectPathIODevices corev1.ResourceName = "dynamicdirectpathiodevices"
+
+	// VirtualMachineQuotaInstanceStorage is the total size of the instance storage volumes.
+	VirtualMachineQuotaInstanceStorage corev1.ResourceName = "instancestorage"
+)
+
+// VirtualMachineQuotaVGPUProfile returns the resource name of the number of vGPU devices of the given profile.
+func VirtualMachineQuotaVGPUProfile(profileName string) corev1.ResourceName {
+	return corev1.ResourceName(VirtualMachineQuotaVGPUProfilePrefix + profileName)
+}
+
+// VirtualMachineQuotaSpec defines the desired state of a VirtualMachineQuota.
+type VirtualMachineQuotaSpec struct {
+	// Hard is the set of enforced hard limits for each tracked resource.  Resources that are not listed are not
+	// limited.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+}
+
+// VirtualMachineQuotaStatus defines the observed state of a VirtualMachineQuota.
+type VirtualMachineQuotaStatus struct {
+	// Hard is the set of enforced hard limits, as last observed.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+
+	// Used is the current usage of the resources limited by Hard, by the VirtualMachines of the namespace.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineQuota.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (quota *VirtualMachineQuota) GetConditions() Conditions {
+	return quota.Status.Conditions
+}
+
+func (quota *VirtualMachineQuota) SetConditions(conditions Conditions) {
+	quota.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmquota
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineQuota is the Schema for the virtualmachinequotas API.
+// A VirtualMachineQuota limits the aggregate virtual hardware consumed by the VirtualMachines of a namespace: their
+// virtual CPUs and memory, their reservations and limits, their vGPU and Dynamic DirectPath I/O devices and their
+// instance storage.  A VirtualMachine whose creation, or whose change of VirtualMachineClass, would exceed one of the
+// hard limits of a VirtualMachineQuota of its namespace is rejected.
+type VirtualMachineQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineQuotaSpec   `json:"spec,omitempty"`
+	Status VirtualMachineQuotaStatus `json:"status,omitempty"`
+}
+
+func (quota *VirtualMachineQuota) NamespacedName() string {
+	return quota.Namespace + "/" + quota.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineQuotaList contains a list of VirtualMachineQuota.
+type VirtualMachineQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineQuota `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineQuota{}, &VirtualMachineQuotaList{})
+}
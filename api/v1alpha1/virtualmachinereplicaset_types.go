@@ -0,0 +1,122 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineTemplateSpec describes the data a VirtualMachine should have when created from a template.
+type VirtualMachineTemplateSpec struct {
+	// Standard object's metadata.
+	// +optional
+	ObjectMeta metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec describes the desired state of the VirtualMachines created from this template.
+	// +optional
+	Spec VirtualMachineSpec `json:"spec,omitempty"`
+}
+
+// VirtualMachineReplicaSetSpec defines the desired state of a VirtualMachineReplicaSet.
+type VirtualMachineReplicaSetSpec struct {
+	// Replicas is the number of desired VirtualMachines.  This is a pointer to distinguish between explicit zero
+	// and unspecified.  Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum:=0
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// MinReadySeconds is the minimum number of seconds for which a newly created VirtualMachine should be ready,
+	// as determined by its ReadinessProbe, without any of its probes failing, for it to be considered available.
+	// Defaults to 0, i.e. the VirtualMachine is considered available as soon as it is ready.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// Selector is a label query over the VirtualMachines that should match the replica count.  A VirtualMachine
+	// must match this selector to be managed by this VirtualMachineReplicaSet, and the selector must match the
+	// labels of Template.
+	Selector *metav1.LabelSelector `json:"selector"`
+
+	// Template describes the VirtualMachines that will be created if insufficient replicas are detected.
+	Template VirtualMachineTemplateSpec `json:"template"`
+}
+
+// VirtualMachineReplicaSetStatus defines the observed state of a VirtualMachineReplicaSet.
+type VirtualMachineReplicaSetStatus struct {
+	// Replicas is the most recently observed number of VirtualMachines matching the selector.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// FullyLabeledReplicas is the number of VirtualMachines whose labels match the labels of the template.
+	// +optional
+	FullyLabeledReplicas int32 `json:"fullyLabeledReplicas,omitempty"`
+
+	// ReadyReplicas is the number of VirtualMachines whose ReadinessProbe is succeeding.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// AvailableReplicas is the number of VirtualMachines that have been ready for at least MinReadySeconds.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// Selector is the string form of the label selector in the spec, used by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the VirtualMachineReplicaSet controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineReplicaSet.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (rs *VirtualMachineReplicaSet) GetConditions() Conditions {
+	return rs.Status.Conditions
+}
+
+func (rs *VirtualMachineReplicaSet) SetConditions(conditions Conditions) {
+	rs.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmrs
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".spec.replicas"
+// +kubebuilder:printcolumn:name="Current",type="integer",JSONPath=".status.replicas"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas"
+// +kubebuilder:printcolumn:name="Available",type="integer",priority=1,JSONPath=".status.availableReplicas"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineReplicaSet is the Schema for the virtualmachinereplicasets API.
+// A VirtualMachineReplicaSet ensures that a specified number of identical VirtualMachines, created from its
+// Template, are running at any given time.
+type VirtualMachineReplicaSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineReplicaSetSpec   `json:"spec,omitempty"`
+	Status VirtualMachineReplicaSetStatus `json:"status,omitempty"`
+}
+
+func (rs *VirtualMachineReplicaSet) NamespacedName() string {
+	return rs.Namespace + "/" + rs.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineReplicaSetList contains a list of VirtualMachineReplicaSet.
+type VirtualMachineReplicaSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineReplicaSet `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineReplicaSet{}, &VirtualMachineReplicaSetList{})
+}
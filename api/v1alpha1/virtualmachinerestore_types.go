@@ -0,0 +1,124 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineRestoreVolumeMapping maps a volume of the backed up VirtualMachine to the PersistentVolumeClaim
+// that is created for it on restore.
+type VirtualMachineRestoreVolumeMapping struct {
+	// VolumeName is the name of the VirtualMachineVolume in the backed up VirtualMachine.
+	VolumeName string `json:"volumeName"`
+
+	// ClaimName is the name of the PersistentVolumeClaim created in the target namespace for the volume.
+	ClaimName string `json:"claimName"`
+}
+
+// VirtualMachineRestoreSpec defines the desired state of a VirtualMachineRestore.
+type VirtualMachineRestoreSpec struct {
+	// BackupName is the name of the completed VirtualMachineBackup, in the same namespace as the
+	// VirtualMachineRestore, that is restored.
+	BackupName string `json:"backupName"`
+
+	// TargetNamespace is the namespace the VirtualMachine and its volumes are recreated in.  Defaults to the
+	// namespace of the VirtualMachineRestore.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
+	// VirtualMachineName is the name of the recreated VirtualMachine.  Defaults to the name of the backed up
+	// VirtualMachine.
+	// +optional
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+
+	// VolumeMappings maps the volumes of the backed up VirtualMachine to the names of the PersistentVolumeClaims
+	// created for them.  Volumes without a mapping are restored to a PersistentVolumeClaim with the name of the
+	// backed up claim.
+	// +optional
+	// +patchMergeKey=volumeName
+	// +patchStrategy=merge
+	VolumeMappings []VirtualMachineRestoreVolumeMapping `json:"volumeMappings,omitempty" patchStrategy:"merge" patchMergeKey:"volumeName"`
+
+	// StorageClass overrides the StorageClass of the recreated VirtualMachine and its volumes.  Defaults to the
+	// StorageClass recorded in the backup.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// PowerState describes the power state of the recreated VirtualMachine.  Defaults to "poweredOff".
+	// +optional
+	PowerState VirtualMachinePowerState `json:"powerState,omitempty"`
+}
+
+// VirtualMachineRestoreStatus defines the observed state of a VirtualMachineRestore.
+type VirtualMachineRestoreStatus struct {
+	// Phase indicates the phase of the VirtualMachineRestore.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// VirtualMachineName is the name of the recreated VirtualMachine, in the target namespace.
+	// +optional
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+
+	// RestoredVolumes lists the PersistentVolumeClaims recreated in the target namespace.
+	// +optional
+	RestoredVolumes []VirtualMachineRestoreVolumeMapping `json:"restoredVolumes,omitempty"`
+
+	// StartTime is the time at which the restore was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time at which the restore completed, successfully or not.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineRestore.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (restore *VirtualMachineRestore) GetConditions() Conditions {
+	return restore.Status.Conditions
+}
+
+func (restore *VirtualMachineRestore) SetConditions(conditions Conditions) {
+	restore.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmrestore
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",priority=1,JSONPath=".status.virtualMachineName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineRestore is the Schema for the virtualmachinerestores API.
+// A VirtualMachineRestore recreates the VirtualMachine and the volumes captured by a VirtualMachineBackup, in the
+// same or in another namespace, optionally under different names.
+type VirtualMachineRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineRestoreSpec   `json:"spec,omitempty"`
+	Status VirtualMachineRestoreStatus `json:"status,omitempty"`
+}
+
+func (restore *VirtualMachineRestore) NamespacedName() string {
+	return restore.Namespace + "/" + restore.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineRestoreList contains a list of VirtualMachineRestore.
+type VirtualMachineRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineRestore `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VirtualMachineRestore{}, &VirtualMachineRestoreList{})
+}
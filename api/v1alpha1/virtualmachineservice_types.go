@@ -120,6 +120,11 @@ type VirtualMachineServiceSpec struct {
 	// and requires Type to be ExternalName.
 	// +optional
 	ExternalName string `json:"externalName,omitempty"`
+
+	// Pause describes a request to stop VM Operator from reconciling this VirtualMachineService.  The Paused
+	// condition reports whether reconciliation is currently paused.
+	// +optional
+	Pause *PauseSpec `json:"pause,omitempty"`
 }
 
 // VirtualMachineServiceStatus defines the observed state of VirtualMachineService
@@ -128,6 +133,18 @@ type VirtualMachineServiceStatus struct {
 	// if one is present.
 	// +optional
 	LoadBalancer LoadBalancerStatus `json:"loadBalancer,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineService.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (s *VirtualMachineService) GetConditions() Conditions {
+	return s.Status.Conditions
+}
+
+func (s *VirtualMachineService) SetConditions(conditions Conditions) {
+	s.Status.Conditions = conditions
 }
 
 // +kubebuilder:object:root=true
@@ -41,11 +41,28 @@ type VirtualMachineSetResourcePolicySpec struct {
 	ResourcePool   ResourcePoolSpec    `json:"resourcepool,omitempty"`
 	Folder         FolderSpec          `json:"folder,omitempty"`
 	ClusterModules []ClusterModuleSpec `json:"clustermodules,omitempty"`
+
+	// Pause describes a request to stop VM Operator from reconciling this VirtualMachineSetResourcePolicy.  The
+	// Paused condition reports whether reconciliation is currently paused.
+	// +optional
+	Pause *PauseSpec `json:"pause,omitempty"`
 }
 
 // VirtualMachineSetResourcePolicyStatus defines the observed state of VirtualMachineSetResourcePolicy
 type VirtualMachineSetResourcePolicyStatus struct {
 	ClusterModules []ClusterModuleStatus `json:"clustermodules,omitempty"`
+
+	// Conditions describes the current condition information of the VirtualMachineSetResourcePolicy.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+func (res *VirtualMachineSetResourcePolicy) GetConditions() Conditions {
+	return res.Status.Conditions
+}
+
+func (res *VirtualMachineSetResourcePolicy) SetConditions(conditions Conditions) {
+	res.Status.Conditions = conditions
 }
 
 type ClusterModuleStatus struct {
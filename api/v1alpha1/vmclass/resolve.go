@@ -0,0 +1,147 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vmclass resolves the ClassName of a VirtualMachine to the effective VirtualMachineClassSpec, honoring the
+// NamespacedVirtualMachineClasses of the namespace first, and the VirtualMachineClasses bound to the namespace by a
+// VirtualMachineClassBinding second.  It also selects the smallest available class that satisfies the Resources of a
+// VirtualMachine that does not specify a ClassName.
+package vmclass
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// Getter looks up the objects involved in the resolution of a ClassName.  The Get methods return nil, and no
+// error, when the object does not exist.
+type Getter interface {
+	// GetNamespacedVirtualMachineClass returns the NamespacedVirtualMachineClass with the given namespace and name.
+	GetNamespacedVirtualMachineClass(namespace, name string) (*v1alpha1.NamespacedVirtualMachineClass, error)
+
+	// ListVirtualMachineClassBindings returns the VirtualMachineClassBindings of the given namespace.
+	ListVirtualMachineClassBindings(namespace string) ([]v1alpha1.VirtualMachineClassBinding, error)
+
+	// GetVirtualMachineClass returns the cluster-scoped VirtualMachineClass with the given name.
+	GetVirtualMachineClass(name string) (*v1alpha1.VirtualMachineClass, error)
+}
+
+// ResolvedClass is the VirtualMachineClass a ClassName resolves to in a namespace.
+type ResolvedClass struct {
+	// Name is the name of the class.
+	Name string
+
+	// Namespace is the namespace of the class when it is a NamespacedVirtualMachineClass, and empty when it is a
+	// cluster-scoped VirtualMachineClass.
+	Namespace string
+
+	// BindingName is the name of the VirtualMachineClassBinding that binds the cluster-scoped VirtualMachineClass to
+	// the namespace.  It is empty for a NamespacedVirtualMachineClass.
+	BindingName string
+
+	// Spec is the spec of the class.
+	Spec v1alpha1.VirtualMachineClassSpec
+}
+
+// IsNamespaced returns true when the class is a NamespacedVirtualMachineClass.
+func (c *ResolvedClass) IsNamespaced() bool {
+	return c.Namespace != ""
+}
+
+// ResolutionError is returned when a ClassName cannot be resolved.  Its Reason is the reason of the
+// VirtualMachinePrereqReadyCondition that documents the failure.
+type ResolutionError struct {
+	Reason  string
+	Message string
+}
+
+func (e *ResolutionError) Error() string {
+	return e.Message
+}
+
+// Resolve returns the class the ClassName resolves to in the namespace.  A NamespacedVirtualMachineClass with the
+// name ClassName takes precedence.  Otherwise, the cluster-scoped VirtualMachineClass with the name ClassName is
+// used, provided it is bound to the namespace by a VirtualMachineClassBinding, either by name or by selector.  The
+// VirtualMachineClassBindings with an invalid ClassSelector are ignored.  A *ResolutionError is returned when no
+// such class exists.
+func Resolve(getter Getter, namespace, className string) (*ResolvedClass, error) {
+	nsClass, err := getter.GetNamespacedVirtualMachineClass(namespace, className)
+	if err != nil {
+		return nil, err
+	}
+	if nsClass != nil {
+		return &ResolvedClass{
+			Name:      nsClass.Name,
+			Namespace: nsClass.Namespace,
+			Spec:      *nsClass.Spec.DeepCopy(),
+		}, nil
+	}
+
+	bindings, err := getter.ListVirtualMachineClassBindings(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	vmClass, err := getter.GetVirtualMachineClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	var bindingName string
+	for i := range bindings {
+		var ok bool
+		if vmClass != nil {
+			// A binding with an invalid ClassSelector is ignored, rather than failing the resolution of every class
+			// of the namespace.
+			ok, _ = BindingSelectsClass(&bindings[i], vmClass)
+		} else {
+			ok = bindings[i].ClassRef.Name == className
+		}
+		if ok {
+			bindingName = bindings[i].Name
+			break
+		}
+	}
+
+	if bindingName == "" {
+		return nil, &ResolutionError{
+			Reason: v1alpha1.VirtualMachineClassBindingNotFoundReason,
+			Message: fmt.Sprintf("VirtualMachineClass %s is not available in namespace %s: "+
+				"no NamespacedVirtualMachineClass or VirtualMachineClassBinding found", className, namespace),
+		}
+	}
+	if vmClass == nil {
+		return nil, &ResolutionError{
+			Reason:  v1alpha1.VirtualMachineClassNotFoundReason,
+			Message: fmt.Sprintf("VirtualMachineClass %s bound by %s/%s not found", className, namespace, bindingName),
+		}
+	}
+
+	return &ResolvedClass{
+		Name:        vmClass.Name,
+		BindingName: bindingName,
+		Spec:        *vmClass.Spec.DeepCopy(),
+	}, nil
+}
+
+// BindingSelectsClass returns true when the VirtualMachineClassBinding binds the VirtualMachineClass, either by name
+// or because the labels of the class match the ClassSelector of the binding.  An empty ClassSelector, which is
+// rejected by validation, selects no class.  An error is returned when the ClassSelector is invalid.
+func BindingSelectsClass(binding *v1alpha1.VirtualMachineClassBinding, vmClass *v1alpha1.VirtualMachineClass) (bool, error) {
+	if binding.ClassSelector == nil {
+		return binding.ClassRef.Name != "" && binding.ClassRef.Name == vmClass.Name, nil
+	}
+	if len(binding.ClassSelector.MatchLabels)+len(binding.ClassSelector.MatchExpressions) == 0 {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(binding.ClassSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid classSelector of VirtualMachineClassBinding %s/%s: %w",
+			binding.Namespace, binding.Name, err)
+	}
+	return selector.Matches(labels.Set(vmClass.Labels)), nil
+}
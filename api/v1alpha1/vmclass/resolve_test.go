@@ -0,0 +1,201 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmclass
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+const namespace = "my-namespace"
+
+func TestResolve(t *testing.T) {
+	getter := &fakeGetter{
+		nsClasses: []v1alpha1.NamespacedVirtualMachineClass{namespacedClass("small", 1)},
+		bindings: []v1alpha1.VirtualMachineClassBinding{
+			bindingByName("bind-small", "small"),
+			bindingByName("bind-medium", "medium"),
+			bindingBySelector("bind-gpu", map[string]string{"gpu": "true"}),
+			bindingByName("bind-missing", "missing"),
+		},
+		classes: []v1alpha1.VirtualMachineClass{
+			clusterClass("small", 2, nil),
+			clusterClass("medium", 4, nil),
+			clusterClass("large-gpu", 8, map[string]string{"gpu": "true"}),
+			clusterClass("unbound", 16, nil),
+		},
+	}
+
+	tests := []struct {
+		className       string
+		expectedCpus    int64
+		expectedBinding string
+		expectedReason  string
+	}{
+		// The NamespacedVirtualMachineClass shadows the bound VirtualMachineClass.
+		{className: "small", expectedCpus: 1},
+		{className: "medium", expectedCpus: 4, expectedBinding: "bind-medium"},
+		{className: "large-gpu", expectedCpus: 8, expectedBinding: "bind-gpu"},
+		{className: "unbound", expectedReason: v1alpha1.VirtualMachineClassBindingNotFoundReason},
+		{className: "missing", expectedReason: v1alpha1.VirtualMachineClassNotFoundReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.className, func(t *testing.T) {
+			resolved, err := Resolve(getter, namespace, tt.className)
+
+			if tt.expectedReason != "" {
+				var resolutionErr *ResolutionError
+				if !errors.As(err, &resolutionErr) || resolutionErr.Reason != tt.expectedReason {
+					t.Fatalf("Resolve() error = %v, want a ResolutionError with reason %s", err, tt.expectedReason)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resolved.Name != tt.className || resolved.Spec.Hardware.Cpus != tt.expectedCpus ||
+				resolved.BindingName != tt.expectedBinding {
+				t.Errorf("Resolve() = %+v, want class %s with %d CPUs bound by %q",
+					resolved, tt.className, tt.expectedCpus, tt.expectedBinding)
+			}
+			if resolved.IsNamespaced() != (tt.expectedBinding == "") {
+				t.Errorf("IsNamespaced() = %t", resolved.IsNamespaced())
+			}
+		})
+	}
+}
+
+func TestResolveInvalidSelector(t *testing.T) {
+	invalid := bindingBySelector("bind-invalid", nil)
+	invalid.ClassSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "gpu", Operator: "Bogus"}}
+
+	getter := &fakeGetter{
+		bindings: []v1alpha1.VirtualMachineClassBinding{invalid, bindingByName("bind-small", "small")},
+		classes:  []v1alpha1.VirtualMachineClass{clusterClass("small", 2, nil), clusterClass("medium", 4, nil)},
+	}
+
+	// The invalid binding does not prevent the resolution of the classes bound by the other bindings.
+	resolved, err := Resolve(getter, namespace, "small")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.BindingName != "bind-small" {
+		t.Errorf("BindingName = %s, want bind-small", resolved.BindingName)
+	}
+
+	var resolutionErr *ResolutionError
+	if _, err := Resolve(getter, namespace, "medium"); !errors.As(err, &resolutionErr) {
+		t.Errorf("Resolve() error = %v, want a ResolutionError", err)
+	}
+
+	if _, err := BindingSelectsClass(&invalid, &getter.classes[0]); err == nil {
+		t.Errorf("BindingSelectsClass() expected an error for an invalid selector")
+	}
+}
+
+func TestBindingSelectsClass(t *testing.T) {
+	gpuClass := clusterClass("large-gpu", 8, map[string]string{"gpu": "true"})
+
+	tests := []struct {
+		name     string
+		binding  v1alpha1.VirtualMachineClassBinding
+		expected bool
+	}{
+		{name: "name", binding: bindingByName("b", "large-gpu"), expected: true},
+		{name: "other name", binding: bindingByName("b", "small")},
+		{name: "selector", binding: bindingBySelector("b", map[string]string{"gpu": "true"}), expected: true},
+		{name: "other selector", binding: bindingBySelector("b", map[string]string{"gpu": "false"})},
+		{name: "empty selector", binding: bindingBySelector("b", nil)},
+		{name: "empty", binding: v1alpha1.VirtualMachineClassBinding{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := BindingSelectsClass(&tt.binding, &gpuClass)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.expected {
+				t.Errorf("BindingSelectsClass() = %t, want %t", ok, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolveGetterError(t *testing.T) {
+	getter := &fakeGetter{err: errors.New("boom")}
+
+	if _, err := Resolve(getter, namespace, "small"); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+type fakeGetter struct {
+	nsClasses []v1alpha1.NamespacedVirtualMachineClass
+	bindings  []v1alpha1.VirtualMachineClassBinding
+	classes   []v1alpha1.VirtualMachineClass
+	err       error
+}
+
+func (g *fakeGetter) GetNamespacedVirtualMachineClass(ns, name string) (*v1alpha1.NamespacedVirtualMachineClass, error) {
+	for i := range g.nsClasses {
+		if g.nsClasses[i].Namespace == ns && g.nsClasses[i].Name == name {
+			return &g.nsClasses[i], nil
+		}
+	}
+	return nil, g.err
+}
+
+func (g *fakeGetter) ListVirtualMachineClassBindings(ns string) ([]v1alpha1.VirtualMachineClassBinding, error) {
+	var bindings []v1alpha1.VirtualMachineClassBinding
+	for _, b := range g.bindings {
+		if b.Namespace == ns {
+			bindings = append(bindings, b)
+		}
+	}
+	return bindings, g.err
+}
+
+func (g *fakeGetter) GetVirtualMachineClass(name string) (*v1alpha1.VirtualMachineClass, error) {
+	for i := range g.classes {
+		if g.classes[i].Name == name {
+			return &g.classes[i], nil
+		}
+	}
+	return nil, g.err
+}
+
+func namespacedClass(name string, cpus int64) v1alpha1.NamespacedVirtualMachineClass {
+	c := v1alpha1.NamespacedVirtualMachineClass{}
+	c.Name, c.Namespace = name, namespace
+	c.Spec.Hardware.Cpus = cpus
+	return c
+}
+
+func clusterClass(name string, cpus int64, labels map[string]string) v1alpha1.VirtualMachineClass {
+	c := v1alpha1.VirtualMachineClass{}
+	c.Name, c.Labels = name, labels
+	c.Spec.Hardware.Cpus = cpus
+	return c
+}
+
+func bindingByName(name, className string) v1alpha1.VirtualMachineClassBinding {
+	b := v1alpha1.VirtualMachineClassBinding{}
+	b.Name, b.Namespace = name, namespace
+	b.ClassRef.Name = className
+	return b
+}
+
+func bindingBySelector(name string, matchLabels map[string]string) v1alpha1.VirtualMachineClassBinding {
+	b := v1alpha1.VirtualMachineClassBinding{}
+	b.Name, b.Namespace = name, namespace
+	b.ClassSelector = &metav1.LabelSelector{MatchLabels: matchLabels}
+	return b
+}
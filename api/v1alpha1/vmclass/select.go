@@ -0,0 +1,158 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmclass
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1/hardware"
+)
+
+// Lister lists the objects involved in the selection of a class for the Resources of a VirtualMachine.
+type Lister interface {
+	// ListNamespacedVirtualMachineClasses returns the NamespacedVirtualMachineClasses of the given namespace.
+	ListNamespacedVirtualMachineClasses(namespace string) ([]v1alpha1.NamespacedVirtualMachineClass, error)
+
+	// ListVirtualMachineClassBindings returns the VirtualMachineClassBindings of the given namespace.
+	ListVirtualMachineClassBindings(namespace string) ([]v1alpha1.VirtualMachineClassBinding, error)
+
+	// ListVirtualMachineClasses returns the cluster-scoped VirtualMachineClasses.
+	ListVirtualMachineClasses() ([]v1alpha1.VirtualMachineClass, error)
+}
+
+// ListAvailable returns the classes available in the namespace, sorted by name: its NamespacedVirtualMachineClasses,
+// and the cluster-scoped VirtualMachineClasses bound to it by a VirtualMachineClassBinding.  As in Resolve, a
+// NamespacedVirtualMachineClass shadows the cluster-scoped VirtualMachineClass with the same name, and a binding with
+// an invalid ClassSelector is ignored.
+func ListAvailable(lister Lister, namespace string) ([]ResolvedClass, error) {
+	nsClasses, err := lister.ListNamespacedVirtualMachineClasses(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := lister.ListVirtualMachineClassBindings(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	vmClasses, err := lister.ListVirtualMachineClasses()
+	if err != nil {
+		return nil, err
+	}
+
+	var classes []ResolvedClass
+	names := sets.NewString()
+
+	for i := range nsClasses {
+		classes = append(classes, ResolvedClass{
+			Name:      nsClasses[i].Name,
+			Namespace: nsClasses[i].Namespace,
+			Spec:      *nsClasses[i].Spec.DeepCopy(),
+		})
+		names.Insert(nsClasses[i].Name)
+	}
+
+	for i := range vmClasses {
+		vmClass := &vmClasses[i]
+		if names.Has(vmClass.Name) {
+			continue
+		}
+
+		for j := range bindings {
+			// A binding with an invalid ClassSelector is ignored, rather than failing the listing of every class of
+			// the namespace.
+			if ok, _ := BindingSelectsClass(&bindings[j], vmClass); ok {
+				classes = append(classes, ResolvedClass{
+					Name:        vmClass.Name,
+					BindingName: bindings[j].Name,
+					Spec:        *vmClass.Spec.DeepCopy(),
+				})
+				break
+			}
+		}
+	}
+
+	sort.Slice(classes, func(i, j int) bool { return classes[i].Name < classes[j].Name })
+	return classes, nil
+}
+
+// Select returns the smallest of the available classes that satisfies the resource requirements of a VirtualMachine,
+// e.g. as listed by ListAvailable.  A class satisfies the requirements when its effective hardware, as computed by
+// the hardware package, provides at least the required number of CPUs, memory and devices, the vGPU devices being
+// only counted when they use one of the required profiles.  The smallest class is the one with the fewest devices,
+// then the fewest CPUs, then the least memory; ties are broken by name.  A *ResolutionError is returned when no class
+// satisfies the requirements, and an error when the effective hardware of a class cannot be computed.
+func Select(req *v1alpha1.VirtualMachineResourceRequirements, classes []ResolvedClass) (*ResolvedClass, error) {
+	var best *ResolvedClass
+	var bestHW *hardware.Effective
+
+	for i := range classes {
+		c := &classes[i]
+
+		vmClass := &v1alpha1.VirtualMachineClass{Spec: c.Spec}
+		vmClass.Name = c.Name
+
+		hw, _, err := hardware.Compute(&v1alpha1.VirtualMachine{}, vmClass, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if !satisfies(req, hw) {
+			continue
+		}
+		if best == nil || smaller(hw, c, bestHW, best) {
+			best, bestHW = c, hw
+		}
+	}
+
+	if best == nil {
+		return nil, &ResolutionError{
+			Reason: v1alpha1.VirtualMachineClassNotSelectedReason,
+			Message: fmt.Sprintf("none of the %d available VirtualMachineClasses provide %d CPUs, %s of memory, "+
+				"%d vGPU and %d Dynamic DirectPath I/O devices", len(classes), req.Cpus, req.Memory.String(),
+				req.VGPUDevices, req.DynamicDirectPathIODevices),
+		}
+	}
+
+	selected := *best
+	return &selected, nil
+}
+
+func satisfies(req *v1alpha1.VirtualMachineResourceRequirements, hw *hardware.Effective) bool {
+	if hw.Cpus < req.Cpus || hw.Memory.Cmp(req.Memory) < 0 {
+		return false
+	}
+
+	profiles := sets.NewString(req.VGPUProfileNames...)
+	numVGPUs := 0
+	for _, d := range hw.Devices.VGPUDevices {
+		if profiles.Len() == 0 || profiles.Has(d.ProfileName) {
+			numVGPUs++
+		}
+	}
+
+	return numVGPUs >= int(req.VGPUDevices) &&
+		len(hw.Devices.DynamicDirectPathIODevices) >= int(req.DynamicDirectPathIODevices)
+}
+
+// smaller returns true when the class a with the hardware hwA is smaller than the class b with the hardware hwB.
+func smaller(hwA *hardware.Effective, a *ResolvedClass, hwB *hardware.Effective, b *ResolvedClass) bool {
+	devicesA := len(hwA.Devices.VGPUDevices) + len(hwA.Devices.DynamicDirectPathIODevices)
+	devicesB := len(hwB.Devices.VGPUDevices) + len(hwB.Devices.DynamicDirectPathIODevices)
+
+	switch {
+	case devicesA != devicesB:
+		return devicesA < devicesB
+	case hwA.Cpus != hwB.Cpus:
+		return hwA.Cpus < hwB.Cpus
+	case hwA.Memory.Cmp(hwB.Memory) != 0:
+		return hwA.Memory.Cmp(hwB.Memory) < 0
+	default:
+		return a.Name < b.Name
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmclass
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+func TestListAvailable(t *testing.T) {
+	invalid := bindingBySelector("bind-invalid", nil)
+	invalid.ClassSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "gpu", Operator: "Bogus"}}
+
+	lister := &fakeGetter{
+		nsClasses: []v1alpha1.NamespacedVirtualMachineClass{namespacedClass("medium", 8)},
+		bindings: []v1alpha1.VirtualMachineClassBinding{
+			invalid,
+			bindingByName("bind-medium", "medium"),
+			bindingBySelector("bind-gpu", map[string]string{"gpu": "true"}),
+		},
+		classes: []v1alpha1.VirtualMachineClass{
+			clusterClass("medium", 4, nil),
+			clusterClass("large-gpu", 16, map[string]string{"gpu": "true"}),
+			clusterClass("small-gpu", 2, map[string]string{"gpu": "true"}),
+			clusterClass("unbound", 2, nil),
+		},
+	}
+
+	classes, err := ListAvailable(lister, namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The NamespacedVirtualMachineClass shadows the bound class with the same name, the unbound class is not
+	// available, and the invalid binding is ignored.
+	var names []string
+	for _, c := range classes {
+		names = append(names, c.Name)
+	}
+	if expected := []string{"large-gpu", "medium", "small-gpu"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("ListAvailable() = %v, want %v", names, expected)
+	}
+	if !classes[1].IsNamespaced() || classes[1].Spec.Hardware.Cpus != 8 {
+		t.Errorf("medium = %+v, want the NamespacedVirtualMachineClass", classes[1])
+	}
+	if classes[0].BindingName != "bind-gpu" {
+		t.Errorf("BindingName = %s, want bind-gpu", classes[0].BindingName)
+	}
+
+	lister.err = errors.New("list failed")
+	if _, err := ListAvailable(lister, namespace); err == nil {
+		t.Errorf("expected the error of the lister")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	classes := []ResolvedClass{
+		resolvedClass("large", 8, "32Gi"),
+		resolvedClass("small", 2, "4Gi"),
+		resolvedClass("small-more-memory", 2, "8Gi"),
+		resolvedClass("medium-gpu", 4, "16Gi", "grid_v100-4q"),
+		resolvedClass("small-gpu", 2, "8Gi", "grid_t4-4q"),
+	}
+
+	tests := []struct {
+		name     string
+		req      v1alpha1.VirtualMachineResourceRequirements
+		expected string
+	}{
+		{
+			name:     "smallest class",
+			req:      v1alpha1.VirtualMachineResourceRequirements{Cpus: 1},
+			expected: "small",
+		},
+		{
+			name:     "memory",
+			req:      v1alpha1.VirtualMachineResourceRequirements{Cpus: 2, Memory: resource.MustParse("6Gi")},
+			expected: "small-more-memory",
+		},
+		{
+			// The classes without devices are preferred, even with more CPUs.
+			name:     "CPUs",
+			req:      v1alpha1.VirtualMachineResourceRequirements{Cpus: 4},
+			expected: "large",
+		},
+		{
+			name:     "any vGPU profile",
+			req:      v1alpha1.VirtualMachineResourceRequirements{VGPUDevices: 1},
+			expected: "small-gpu",
+		},
+		{
+			name: "vGPU profile",
+			req: v1alpha1.VirtualMachineResourceRequirements{
+				VGPUDevices:      1,
+				VGPUProfileNames: []string{"grid_v100-4q"},
+			},
+			expected: "medium-gpu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := Select(&tt.req, classes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if selected.Name != tt.expected {
+				t.Errorf("Select() = %s, want %s", selected.Name, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSelectNone(t *testing.T) {
+	classes := []ResolvedClass{resolvedClass("small", 2, "4Gi")}
+
+	for name, req := range map[string]v1alpha1.VirtualMachineResourceRequirements{
+		"CPUs":        {Cpus: 4},
+		"memory":      {Memory: resource.MustParse("8Gi")},
+		"vGPU":        {VGPUDevices: 1},
+		"passthrough": {DynamicDirectPathIODevices: 1},
+	} {
+		req := req
+		_, err := Select(&req, classes)
+
+		var resolutionErr *ResolutionError
+		if !errors.As(err, &resolutionErr) {
+			t.Errorf("%s: Select() error = %v, want a ResolutionError", name, err)
+		} else if resolutionErr.Reason != v1alpha1.VirtualMachineClassNotSelectedReason {
+			t.Errorf("%s: Reason = %s, want %s", name, resolutionErr.Reason, v1alpha1.VirtualMachineClassNotSelectedReason)
+		}
+	}
+}
+
+func TestSelectInvalidConfigSpec(t *testing.T) {
+	invalid := resolvedClass("invalid", 2, "4Gi")
+	invalid.Spec.ConfigSpec = &v1alpha1.VirtualMachineConfigSpec{XML: "not base64"}
+
+	var resolutionErr *ResolutionError
+	if _, err := Select(&v1alpha1.VirtualMachineResourceRequirements{}, []ResolvedClass{invalid}); err == nil ||
+		errors.As(err, &resolutionErr) {
+		t.Errorf("Select() error = %v, want an error computing the hardware", err)
+	}
+}
+
+func (g *fakeGetter) ListNamespacedVirtualMachineClasses(ns string) ([]v1alpha1.NamespacedVirtualMachineClass, error) {
+	var classes []v1alpha1.NamespacedVirtualMachineClass
+	for _, c := range g.nsClasses {
+		if c.Namespace == ns {
+			classes = append(classes, c)
+		}
+	}
+	return classes, g.err
+}
+
+func (g *fakeGetter) ListVirtualMachineClasses() ([]v1alpha1.VirtualMachineClass, error) {
+	return g.classes, g.err
+}
+
+func resolvedClass(name string, cpus int64, memory string, vgpuProfileNames ...string) ResolvedClass {
+	c := ResolvedClass{Name: name}
+	c.Spec.Hardware.Cpus = cpus
+	c.Spec.Hardware.Memory = resource.MustParse(memory)
+	for _, p := range vgpuProfileNames {
+		c.Spec.Hardware.Devices.VGPUDevices = append(c.Spec.Hardware.Devices.VGPUDevices,
+			v1alpha1.VGPUDevice{ProfileName: p})
+	}
+	return c
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package wellknown
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HasFinalizer returns true when the object has the finalizer.
+func HasFinalizer(obj metav1.Object, finalizer string) bool {
+	for _, f := range obj.GetFinalizers() {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// AddFinalizer adds the finalizer to the object, unless the object already has it.  It returns true when the
+// finalizers of the object were changed.
+func AddFinalizer(obj metav1.Object, finalizer string) bool {
+	if HasFinalizer(obj, finalizer) {
+		return false
+	}
+	obj.SetFinalizers(append(obj.GetFinalizers(), finalizer))
+	return true
+}
+
+// RemoveFinalizer removes all occurrences of the finalizer from the object.  It returns true when the finalizers of
+// the object were changed.
+func RemoveFinalizer(obj metav1.Object, finalizer string) bool {
+	finalizers := obj.GetFinalizers()
+	result := make([]string, 0, len(finalizers))
+	for _, f := range finalizers {
+		if f != finalizer {
+			result = append(result, f)
+		}
+	}
+	if len(result) == len(finalizers) {
+		return false
+	}
+	obj.SetFinalizers(result)
+	return true
+}
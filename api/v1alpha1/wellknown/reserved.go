@@ -0,0 +1,80 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package wellknown
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// userAnnotations are the annotations with a reserved prefix that users are allowed to set.
+var userAnnotations = map[string]struct{}{
+	PauseAnnotation: {},
+}
+
+// operatorLabels are the labels without a reserved prefix that VM Operator applies to a VirtualMachine, and that
+// users are not allowed to set, since the affinity and topology rules rely on their values.
+var operatorLabels = map[string]struct{}{
+	ZoneLabel: {},
+	HostLabel: {},
+}
+
+// IsReservedKey returns true when the prefix of the label or annotation key is GroupName or one of its subdomains.
+// Such keys are reserved for use by VM Operator.
+func IsReservedKey(key string) bool {
+	i := strings.IndexByte(key, '/')
+	if i < 0 {
+		return false
+	}
+	prefix := key[:i]
+	return prefix == v1alpha1.GroupName || strings.HasSuffix(prefix, "."+v1alpha1.GroupName)
+}
+
+// ValidateUserLabels returns an error for each user-supplied label whose key is reserved for use by VM Operator,
+// including the ZoneLabel and HostLabel VM Operator applies to a VirtualMachine.
+func ValidateUserLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, k := range sortedKeys(labels) {
+		if _, ok := operatorLabels[k]; ok {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(k), "label is applied by VM Operator"))
+		} else if IsReservedKey(k) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(k),
+				"label prefix "+v1alpha1.GroupName+" is reserved for use by VM Operator"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateUserAnnotations returns an error for each user-supplied annotation whose key is reserved for use by
+// VM Operator, with the exception of the annotations users are expected to set, such as the PauseAnnotation.
+func ValidateUserAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, k := range sortedKeys(annotations) {
+		if _, ok := userAnnotations[k]; ok {
+			continue
+		}
+		if IsReservedKey(k) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(k),
+				"annotation prefix "+v1alpha1.GroupName+" is reserved for use by VM Operator"))
+		}
+	}
+
+	return allErrs
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
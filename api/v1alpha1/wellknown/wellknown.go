@@ -0,0 +1,98 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package wellknown contains the well-known label, annotation and finalizer names used by VM Operator, along with
+// helpers to manage finalizers and to keep user-supplied labels and annotations out of the keys reserved by
+// VM Operator.
+package wellknown
+
+import (
+	"github.com/acharyasreej/vm-operator-api/api/v1alpha1"
+)
+
+// Finalizers added by VM Operator to the objects whose deletion requires cleaning up the vSphere infrastructure.
+const (
+	// VirtualMachineFinalizer is added to a VirtualMachine until its vSphere VM has been deleted.
+	VirtualMachineFinalizer = "virtualmachine." + v1alpha1.GroupName
+
+	// VirtualMachineServiceFinalizer is added to a VirtualMachineService until its load balancer has been deleted.
+	VirtualMachineServiceFinalizer = "virtualmachineservice." + v1alpha1.GroupName
+
+	// VirtualMachineSetResourcePolicyFinalizer is added to a VirtualMachineSetResourcePolicy until its resource
+	// pool, folder and cluster modules have been deleted.
+	VirtualMachineSetResourcePolicyFinalizer = "virtualmachinesetresourcepolicy." + v1alpha1.GroupName
+
+	// VirtualMachineReplicaSetFinalizer is added to a VirtualMachineReplicaSet until its VirtualMachines have been
+	// deleted or orphaned.
+	VirtualMachineReplicaSetFinalizer = "virtualmachinereplicaset." + v1alpha1.GroupName
+
+	// VirtualMachineDeploymentFinalizer is added to a VirtualMachineDeployment until its VirtualMachineReplicaSets
+	// have been deleted or orphaned.
+	VirtualMachineDeploymentFinalizer = "virtualmachinedeployment." + v1alpha1.GroupName
+
+	// VirtualMachinePublishRequestFinalizer is added to a VirtualMachinePublishRequest until its upload task has
+	// completed or been cancelled.
+	VirtualMachinePublishRequestFinalizer = "virtualmachinepublishrequest." + v1alpha1.GroupName
+
+	// VirtualMachineImportFinalizer is added to a VirtualMachineImport until the import has completed or been
+	// rolled back.
+	VirtualMachineImportFinalizer = "virtualmachineimport." + v1alpha1.GroupName
+
+	// VirtualMachineMigrationFinalizer is added to a VirtualMachineMigration until its migration task has
+	// completed or been cancelled.
+	VirtualMachineMigrationFinalizer = "virtualmachinemigration." + v1alpha1.GroupName
+
+	// VirtualMachineBackupFinalizer is added to a VirtualMachineBackup until its snapshot has been released.
+	VirtualMachineBackupFinalizer = "virtualmachinebackup." + v1alpha1.GroupName
+
+	// VirtualMachineRestoreFinalizer is added to a VirtualMachineRestore until the restore has completed or been
+	// rolled back.
+	VirtualMachineRestoreFinalizer = "virtualmachinerestore." + v1alpha1.GroupName
+)
+
+// Labels applied by VM Operator.
+const (
+	// ManagedByLabel is the recommended Kubernetes label identifying the tool that manages an object.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+
+	// ManagedByValue is the value of the ManagedByLabel for objects managed by VM Operator.
+	ManagedByValue = "vm-operator"
+
+	// ZoneLabel is applied to a VirtualMachine with the name of the AvailabilityZone it is placed in.
+	ZoneLabel = v1alpha1.VirtualMachineAffinityTopologyKeyZone
+
+	// HostLabel is applied to a VirtualMachine with the name of the host it is executing on.
+	HostLabel = v1alpha1.VirtualMachineAffinityTopologyKeyHost
+
+	// ImageNameLabel is applied to a VirtualMachine with the name of the VirtualMachineImage it was deployed from.
+	ImageNameLabel = v1alpha1.GroupName + "/image-name"
+
+	// ClassNameLabel is applied to a VirtualMachine with the name of the VirtualMachineClass it references.
+	ClassNameLabel = v1alpha1.GroupName + "/class-name"
+
+	// ReplicaSetNameLabel is applied to a VirtualMachine with the name of the VirtualMachineReplicaSet that
+	// created it.
+	ReplicaSetNameLabel = v1alpha1.GroupName + "/replicaset-name"
+
+	// DeploymentNameLabel is applied to a VirtualMachineReplicaSet with the name of the VirtualMachineDeployment
+	// that created it.
+	DeploymentNameLabel = v1alpha1.GroupName + "/deployment-name"
+
+	// TemplateHashLabel is applied to the VirtualMachineReplicaSets of a VirtualMachineDeployment, and to their
+	// VirtualMachines, with the hash of the template they were created from.
+	TemplateHashLabel = v1alpha1.GroupName + "/vm-template-hash"
+)
+
+// Annotations used by VM Operator.
+const (
+	// PauseAnnotation pauses the reconciliation of an object.  See v1alpha1.PauseAnnotation.
+	PauseAnnotation = v1alpha1.PauseAnnotation
+
+	// ImportedFromAnnotation records the VirtualMachineImport that produced a VirtualMachine.  See
+	// v1alpha1.ImportedFromAnnotation.
+	ImportedFromAnnotation = v1alpha1.ImportedFromAnnotation
+
+	// RevisionAnnotation records the rollout revision of a VirtualMachineReplicaSet.  See
+	// v1alpha1.VirtualMachineDeploymentRevisionAnnotation.
+	RevisionAnnotation = v1alpha1.VirtualMachineDeploymentRevisionAnnotation
+)
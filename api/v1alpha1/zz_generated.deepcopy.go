@@ -9,10 +9,120 @@
 package v1alpha1
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZone.
+func (in *AvailabilityZone) DeepCopy() *AvailabilityZone {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilityZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AvailabilityZone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilityZoneList) DeepCopyInto(out *AvailabilityZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AvailabilityZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZoneList.
+func (in *AvailabilityZoneList) DeepCopy() *AvailabilityZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilityZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AvailabilityZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilityZoneSpec) DeepCopyInto(out *AvailabilityZoneSpec) {
+	*out = *in
+	if in.ClusterComputeResourceMoIDs != nil {
+		in, out := &in.ClusterComputeResourceMoIDs, &out.ClusterComputeResourceMoIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]NamespaceInfo, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZoneSpec.
+func (in *AvailabilityZoneSpec) DeepCopy() *AvailabilityZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilityZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilityZoneStatus) DeepCopyInto(out *AvailabilityZoneStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZoneStatus.
+func (in *AvailabilityZoneStatus) DeepCopy() *AvailabilityZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilityZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClassReference) DeepCopyInto(out *ClassReference) {
 	*out = *in
@@ -288,6 +398,123 @@ func (in Conditions) DeepCopy() Conditions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigSpecDevice) DeepCopyInto(out *ConfigSpecDevice) {
+	*out = *in
+	if in.ControllerKey != nil {
+		in, out := &in.ControllerKey, &out.ControllerKey
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnitNumber != nil {
+		in, out := &in.UnitNumber, &out.UnitNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NIC != nil {
+		in, out := &in.NIC, &out.NIC
+		*out = new(ConfigSpecNIC)
+		**out = **in
+	}
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(ConfigSpecDisk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VGPU != nil {
+		in, out := &in.VGPU, &out.VGPU
+		*out = new(VGPUDevice)
+		**out = **in
+	}
+	if in.DynamicDirectPathIO != nil {
+		in, out := &in.DynamicDirectPathIO, &out.DynamicDirectPathIO
+		*out = new(ConfigSpecDynamicDirectPathIO)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSpecDevice.
+func (in *ConfigSpecDevice) DeepCopy() *ConfigSpecDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigSpecDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigSpecDeviceChange) DeepCopyInto(out *ConfigSpecDeviceChange) {
+	*out = *in
+	in.Device.DeepCopyInto(&out.Device)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSpecDeviceChange.
+func (in *ConfigSpecDeviceChange) DeepCopy() *ConfigSpecDeviceChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigSpecDeviceChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigSpecDisk) DeepCopyInto(out *ConfigSpecDisk) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.ThinProvisioned != nil {
+		in, out := &in.ThinProvisioned, &out.ThinProvisioned
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSpecDisk.
+func (in *ConfigSpecDisk) DeepCopy() *ConfigSpecDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigSpecDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigSpecDynamicDirectPathIO) DeepCopyInto(out *ConfigSpecDynamicDirectPathIO) {
+	*out = *in
+	if in.AllowedDevices != nil {
+		in, out := &in.AllowedDevices, &out.AllowedDevices
+		*out = make([]DynamicDirectPathIODevice, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSpecDynamicDirectPathIO.
+func (in *ConfigSpecDynamicDirectPathIO) DeepCopy() *ConfigSpecDynamicDirectPathIO {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigSpecDynamicDirectPathIO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigSpecNIC) DeepCopyInto(out *ConfigSpecNIC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSpecNIC.
+func (in *ConfigSpecNIC) DeepCopy() *ConfigSpecNIC {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigSpecNIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContentLibrary) DeepCopyInto(out *ContentLibrary) {
 	*out = *in
@@ -995,6 +1222,80 @@ func (in *LoadBalancerStatus) DeepCopy() *LoadBalancerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceInfo) DeepCopyInto(out *NamespaceInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceInfo.
+func (in *NamespaceInfo) DeepCopy() *NamespaceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedVirtualMachineClass) DeepCopyInto(out *NamespacedVirtualMachineClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedVirtualMachineClass.
+func (in *NamespacedVirtualMachineClass) DeepCopy() *NamespacedVirtualMachineClass {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedVirtualMachineClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedVirtualMachineClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedVirtualMachineClassList) DeepCopyInto(out *NamespacedVirtualMachineClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedVirtualMachineClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedVirtualMachineClassList.
+func (in *NamespacedVirtualMachineClassList) DeepCopy() *NamespacedVirtualMachineClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedVirtualMachineClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedVirtualMachineClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfaceProviderReference) DeepCopyInto(out *NetworkInterfaceProviderReference) {
 	*out = *in
@@ -1050,6 +1351,25 @@ func (in *OvfProperty) DeepCopy() *OvfProperty {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PauseSpec) DeepCopyInto(out *PauseSpec) {
+	*out = *in
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseSpec.
+func (in *PauseSpec) DeepCopy() *PauseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PauseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeClaimVolumeSource) DeepCopyInto(out *PersistentVolumeClaimVolumeSource) {
 	*out = *in
@@ -1114,47 +1434,111 @@ func (in *ResourcePoolSpec) DeepCopy() *ResourcePoolSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageBacking) DeepCopyInto(out *StorageBacking) {
+func (in *RollingUpdateVirtualMachineDeployment) DeepCopyInto(out *RollingUpdateVirtualMachineDeployment) {
 	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBacking.
-func (in *StorageBacking) DeepCopy() *StorageBacking {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateVirtualMachineDeployment.
+func (in *RollingUpdateVirtualMachineDeployment) DeepCopy() *RollingUpdateVirtualMachineDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageBacking)
+	out := new(RollingUpdateVirtualMachineDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TCPSocketAction) DeepCopyInto(out *TCPSocketAction) {
+func (in *StorageBacking) DeepCopyInto(out *StorageBacking) {
 	*out = *in
-	out.Port = in.Port
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPSocketAction.
-func (in *TCPSocketAction) DeepCopy() *TCPSocketAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBacking.
+func (in *StorageBacking) DeepCopy() *StorageBacking {
 	if in == nil {
 		return nil
 	}
-	out := new(TCPSocketAction)
+	out := new(StorageBacking)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UploadSpec) DeepCopyInto(out *UploadSpec) {
+func (in *StructuredConfigSpec) DeepCopyInto(out *StructuredConfigSpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UploadSpec.
-func (in *UploadSpec) DeepCopy() *UploadSpec {
-	if in == nil {
-		return nil
+	if in.CpuShares != nil {
+		in, out := &in.CpuShares, &out.CpuShares
+		*out = new(VirtualMachineSharesSpec)
+		**out = **in
 	}
-	out := new(UploadSpec)
+	if in.MemoryShares != nil {
+		in, out := &in.MemoryShares, &out.MemoryShares
+		*out = new(VirtualMachineSharesSpec)
+		**out = **in
+	}
+	if in.DeviceChanges != nil {
+		in, out := &in.DeviceChanges, &out.DeviceChanges
+		*out = make([]ConfigSpecDeviceChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraConfig != nil {
+		in, out := &in.ExtraConfig, &out.ExtraConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructuredConfigSpec.
+func (in *StructuredConfigSpec) DeepCopy() *StructuredConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StructuredConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPSocketAction) DeepCopyInto(out *TCPSocketAction) {
+	*out = *in
+	out.Port = in.Port
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPSocketAction.
+func (in *TCPSocketAction) DeepCopy() *TCPSocketAction {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPSocketAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UploadSpec) DeepCopyInto(out *UploadSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UploadSpec.
+func (in *UploadSpec) DeepCopy() *UploadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UploadSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1239,6 +1623,11 @@ func (in *VirtualMachineAdvancedOptions) DeepCopyInto(out *VirtualMachineAdvance
 		*out = new(bool)
 		**out = **in
 	}
+	if in.VirtualTPM != nil {
+		in, out := &in.VirtualTPM, &out.VirtualTPM
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAdvancedOptions.
@@ -1252,84 +1641,149 @@ func (in *VirtualMachineAdvancedOptions) DeepCopy() *VirtualMachineAdvancedOptio
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClass) DeepCopyInto(out *VirtualMachineClass) {
+func (in *VirtualMachineAffinity) DeepCopyInto(out *VirtualMachineAffinity) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]VirtualMachineAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedVirtualMachineAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClass.
-func (in *VirtualMachineClass) DeepCopy() *VirtualMachineClass {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAffinity.
+func (in *VirtualMachineAffinity) DeepCopy() *VirtualMachineAffinity {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClass)
+	out := new(VirtualMachineAffinity)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineClass) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineAffinitySpec) DeepCopyInto(out *VirtualMachineAffinitySpec) {
+	*out = *in
+	if in.VirtualMachineAffinity != nil {
+		in, out := &in.VirtualMachineAffinity, &out.VirtualMachineAffinity
+		*out = new(VirtualMachineAffinity)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
+	if in.VirtualMachineAntiAffinity != nil {
+		in, out := &in.VirtualMachineAntiAffinity, &out.VirtualMachineAntiAffinity
+		*out = new(VirtualMachineAntiAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAffinitySpec.
+func (in *VirtualMachineAffinitySpec) DeepCopy() *VirtualMachineAffinitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineAffinitySpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassBinding) DeepCopyInto(out *VirtualMachineClassBinding) {
+func (in *VirtualMachineAffinityTerm) DeepCopyInto(out *VirtualMachineAffinityTerm) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.ClassRef = in.ClassRef
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassBinding.
-func (in *VirtualMachineClassBinding) DeepCopy() *VirtualMachineClassBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAffinityTerm.
+func (in *VirtualMachineAffinityTerm) DeepCopy() *VirtualMachineAffinityTerm {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassBinding)
+	out := new(VirtualMachineAffinityTerm)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineClassBinding) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineAffinityViolation) DeepCopyInto(out *VirtualMachineAffinityViolation) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAffinityViolation.
+func (in *VirtualMachineAffinityViolation) DeepCopy() *VirtualMachineAffinityViolation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineAffinityViolation)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassBindingList) DeepCopyInto(out *VirtualMachineClassBindingList) {
+func (in *VirtualMachineAntiAffinity) DeepCopyInto(out *VirtualMachineAntiAffinity) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineClassBinding, len(*in))
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]VirtualMachineAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedVirtualMachineAffinityTerm, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassBindingList.
-func (in *VirtualMachineClassBindingList) DeepCopy() *VirtualMachineClassBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAntiAffinity.
+func (in *VirtualMachineAntiAffinity) DeepCopy() *VirtualMachineAntiAffinity {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassBindingList)
+	out := new(VirtualMachineAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackup) DeepCopyInto(out *VirtualMachineBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackup.
+func (in *VirtualMachineBackup) DeepCopy() *VirtualMachineBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineClassBindingList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineBackup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1337,49 +1791,51 @@ func (in *VirtualMachineClassBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassHardware) DeepCopyInto(out *VirtualMachineClassHardware) {
+func (in *VirtualMachineBackupDiskStatus) DeepCopyInto(out *VirtualMachineBackupDiskStatus) {
 	*out = *in
-	out.Memory = in.Memory.DeepCopy()
-	in.Devices.DeepCopyInto(&out.Devices)
-	in.InstanceStorage.DeepCopyInto(&out.InstanceStorage)
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassHardware.
-func (in *VirtualMachineClassHardware) DeepCopy() *VirtualMachineClassHardware {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupDiskStatus.
+func (in *VirtualMachineBackupDiskStatus) DeepCopy() *VirtualMachineBackupDiskStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassHardware)
+	out := new(VirtualMachineBackupDiskStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassList) DeepCopyInto(out *VirtualMachineClassList) {
+func (in *VirtualMachineBackupList) DeepCopyInto(out *VirtualMachineBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineClass, len(*in))
+		*out = make([]VirtualMachineBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassList.
-func (in *VirtualMachineClassList) DeepCopy() *VirtualMachineClassList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupList.
+func (in *VirtualMachineBackupList) DeepCopy() *VirtualMachineBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassList)
+	out := new(VirtualMachineBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineClassList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1387,257 +1843,1670 @@ func (in *VirtualMachineClassList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassPolicies) DeepCopyInto(out *VirtualMachineClassPolicies) {
+func (in *VirtualMachineBackupSpec) DeepCopyInto(out *VirtualMachineBackupSpec) {
 	*out = *in
-	in.Resources.DeepCopyInto(&out.Resources)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassPolicies.
-func (in *VirtualMachineClassPolicies) DeepCopy() *VirtualMachineClassPolicies {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupSpec.
+func (in *VirtualMachineBackupSpec) DeepCopy() *VirtualMachineBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassPolicies)
+	out := new(VirtualMachineBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassResources) DeepCopyInto(out *VirtualMachineClassResources) {
+func (in *VirtualMachineBackupStatus) DeepCopyInto(out *VirtualMachineBackupStatus) {
 	*out = *in
-	in.Requests.DeepCopyInto(&out.Requests)
-	in.Limits.DeepCopyInto(&out.Limits)
+	if in.VirtualMachineSpec != nil {
+		in, out := &in.VirtualMachineSpec, &out.VirtualMachineSpec
+		*out = new(VirtualMachineSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMachineLabels != nil {
+		in, out := &in.VirtualMachineLabels, &out.VirtualMachineLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ClassSpec != nil {
+		in, out := &in.ClassSpec, &out.ClassSpec
+		*out = new(VirtualMachineClassSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineBackupDiskStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassResources.
-func (in *VirtualMachineClassResources) DeepCopy() *VirtualMachineClassResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupStatus.
+func (in *VirtualMachineBackupStatus) DeepCopy() *VirtualMachineBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassResources)
+	out := new(VirtualMachineBackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassSpec) DeepCopyInto(out *VirtualMachineClassSpec) {
+func (in *VirtualMachineClass) DeepCopyInto(out *VirtualMachineClass) {
 	*out = *in
-	in.Hardware.DeepCopyInto(&out.Hardware)
-	in.Policies.DeepCopyInto(&out.Policies)
-	if in.ConfigSpec != nil {
-		in, out := &in.ConfigSpec, &out.ConfigSpec
-		*out = new(VirtualMachineConfigSpec)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassSpec.
-func (in *VirtualMachineClassSpec) DeepCopy() *VirtualMachineClassSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClass.
+func (in *VirtualMachineClass) DeepCopy() *VirtualMachineClass {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassSpec)
+	out := new(VirtualMachineClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineClassStatus) DeepCopyInto(out *VirtualMachineClassStatus) {
+func (in *VirtualMachineClassBinding) DeepCopyInto(out *VirtualMachineClassBinding) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.ClassRef = in.ClassRef
+	if in.ClassSelector != nil {
+		in, out := &in.ClassSelector, &out.ClassSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassStatus.
-func (in *VirtualMachineClassStatus) DeepCopy() *VirtualMachineClassStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassBinding.
+func (in *VirtualMachineClassBinding) DeepCopy() *VirtualMachineClassBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineClassStatus)
+	out := new(VirtualMachineClassBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClassBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineConfigSpec) DeepCopyInto(out *VirtualMachineConfigSpec) {
+func (in *VirtualMachineClassBindingList) DeepCopyInto(out *VirtualMachineClassBindingList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineClassBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineConfigSpec.
-func (in *VirtualMachineConfigSpec) DeepCopy() *VirtualMachineConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassBindingList.
+func (in *VirtualMachineClassBindingList) DeepCopy() *VirtualMachineClassBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineConfigSpec)
+	out := new(VirtualMachineClassBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImage) DeepCopyInto(out *VirtualMachineImage) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClassBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassBindingStatus) DeepCopyInto(out *VirtualMachineClassBindingStatus) {
+	*out = *in
+	if in.ClassNames != nil {
+		in, out := &in.ClassNames, &out.ClassNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassBindingStatus.
+func (in *VirtualMachineClassBindingStatus) DeepCopy() *VirtualMachineClassBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassDevicePolicy) DeepCopyInto(out *VirtualMachineClassDevicePolicy) {
+	*out = *in
+	if in.AllowedVGPUProfileNames != nil {
+		in, out := &in.AllowedVGPUProfileNames, &out.AllowedVGPUProfileNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedDynamicDirectPathIODevices != nil {
+		in, out := &in.AllowedDynamicDirectPathIODevices, &out.AllowedDynamicDirectPathIODevices
+		*out = make([]DynamicDirectPathIODevice, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassDevicePolicy.
+func (in *VirtualMachineClassDevicePolicy) DeepCopy() *VirtualMachineClassDevicePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassDevicePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassHardware) DeepCopyInto(out *VirtualMachineClassHardware) {
+	*out = *in
+	out.Memory = in.Memory.DeepCopy()
+	in.Devices.DeepCopyInto(&out.Devices)
+	in.InstanceStorage.DeepCopyInto(&out.InstanceStorage)
+	if in.NUMA != nil {
+		in, out := &in.NUMA, &out.NUMA
+		*out = new(VirtualMachineNUMASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CpuShares != nil {
+		in, out := &in.CpuShares, &out.CpuShares
+		*out = new(VirtualMachineSharesSpec)
+		**out = **in
+	}
+	if in.MemoryShares != nil {
+		in, out := &in.MemoryShares, &out.MemoryShares
+		*out = new(VirtualMachineSharesSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassHardware.
+func (in *VirtualMachineClassHardware) DeepCopy() *VirtualMachineClassHardware {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassHardware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassList) DeepCopyInto(out *VirtualMachineClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassList.
+func (in *VirtualMachineClassList) DeepCopy() *VirtualMachineClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassPolicies) DeepCopyInto(out *VirtualMachineClassPolicies) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = new(VirtualMachineClassDevicePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassPolicies.
+func (in *VirtualMachineClassPolicies) DeepCopy() *VirtualMachineClassPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassResources) DeepCopyInto(out *VirtualMachineClassResources) {
+	*out = *in
+	in.Requests.DeepCopyInto(&out.Requests)
+	in.Limits.DeepCopyInto(&out.Limits)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassResources.
+func (in *VirtualMachineClassResources) DeepCopy() *VirtualMachineClassResources {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassSpec) DeepCopyInto(out *VirtualMachineClassSpec) {
+	*out = *in
+	in.Hardware.DeepCopyInto(&out.Hardware)
+	in.Policies.DeepCopyInto(&out.Policies)
+	if in.ConfigSpec != nil {
+		in, out := &in.ConfigSpec, &out.ConfigSpec
+		*out = new(VirtualMachineConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassSpec.
+func (in *VirtualMachineClassSpec) DeepCopy() *VirtualMachineClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassStatus) DeepCopyInto(out *VirtualMachineClassStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassStatus.
+func (in *VirtualMachineClassStatus) DeepCopy() *VirtualMachineClassStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineConfigSpec) DeepCopyInto(out *VirtualMachineConfigSpec) {
+	*out = *in
+	if in.Structured != nil {
+		in, out := &in.Structured, &out.Structured
+		*out = new(StructuredConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineConfigSpec.
+func (in *VirtualMachineConfigSpec) DeepCopy() *VirtualMachineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCryptoSpec) DeepCopyInto(out *VirtualMachineCryptoSpec) {
+	*out = *in
+	if in.EncryptDisksByDefault != nil {
+		in, out := &in.EncryptDisksByDefault, &out.EncryptDisksByDefault
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCryptoSpec.
+func (in *VirtualMachineCryptoSpec) DeepCopy() *VirtualMachineCryptoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCryptoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCryptoStatus) DeepCopyInto(out *VirtualMachineCryptoStatus) {
+	*out = *in
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = make([]VirtualMachineEncryptionType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCryptoStatus.
+func (in *VirtualMachineCryptoStatus) DeepCopy() *VirtualMachineCryptoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCryptoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployment) DeepCopyInto(out *VirtualMachineDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployment.
+func (in *VirtualMachineDeployment) DeepCopy() *VirtualMachineDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentList) DeepCopyInto(out *VirtualMachineDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentList.
+func (in *VirtualMachineDeploymentList) DeepCopy() *VirtualMachineDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentRollback) DeepCopyInto(out *VirtualMachineDeploymentRollback) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentRollback.
+func (in *VirtualMachineDeploymentRollback) DeepCopy() *VirtualMachineDeploymentRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentSpec) DeepCopyInto(out *VirtualMachineDeploymentSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RollbackTo != nil {
+		in, out := &in.RollbackTo, &out.RollbackTo
+		*out = new(VirtualMachineDeploymentRollback)
+		**out = **in
+	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentSpec.
+func (in *VirtualMachineDeploymentSpec) DeepCopy() *VirtualMachineDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentStatus) DeepCopyInto(out *VirtualMachineDeploymentStatus) {
+	*out = *in
+	if in.CollisionCount != nil {
+		in, out := &in.CollisionCount, &out.CollisionCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentStatus.
+func (in *VirtualMachineDeploymentStatus) DeepCopy() *VirtualMachineDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentStrategy) DeepCopyInto(out *VirtualMachineDeploymentStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateVirtualMachineDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentStrategy.
+func (in *VirtualMachineDeploymentStrategy) DeepCopy() *VirtualMachineDeploymentStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeviceStatus) DeepCopyInto(out *VirtualMachineDeviceStatus) {
+	*out = *in
+	if in.DeviceKey != nil {
+		in, out := &in.DeviceKey, &out.DeviceKey
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeviceStatus.
+func (in *VirtualMachineDeviceStatus) DeepCopy() *VirtualMachineDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDiskMetrics) DeepCopyInto(out *VirtualMachineDiskMetrics) {
+	*out = *in
+	out.ReadThroughput = in.ReadThroughput.DeepCopy()
+	out.WriteThroughput = in.WriteThroughput.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDiskMetrics.
+func (in *VirtualMachineDiskMetrics) DeepCopy() *VirtualMachineDiskMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDiskMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImage) DeepCopyInto(out *VirtualMachineImage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImage.
+func (in *VirtualMachineImage) DeepCopy() *VirtualMachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageList) DeepCopyInto(out *VirtualMachineImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageList.
+func (in *VirtualMachineImageList) DeepCopy() *VirtualMachineImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageOSInfo) DeepCopyInto(out *VirtualMachineImageOSInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageOSInfo.
+func (in *VirtualMachineImageOSInfo) DeepCopy() *VirtualMachineImageOSInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageOSInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageProductInfo) DeepCopyInto(out *VirtualMachineImageProductInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageProductInfo.
+func (in *VirtualMachineImageProductInfo) DeepCopy() *VirtualMachineImageProductInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageProductInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageSpec) DeepCopyInto(out *VirtualMachineImageSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	out.ProductInfo = in.ProductInfo
+	out.OSInfo = in.OSInfo
+	if in.OVFEnv != nil {
+		in, out := &in.OVFEnv, &out.OVFEnv
+		*out = make(map[string]OvfProperty, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageSpec.
+func (in *VirtualMachineImageSpec) DeepCopy() *VirtualMachineImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageStatus) DeepCopyInto(out *VirtualMachineImageStatus) {
+	*out = *in
+	if in.ImageSupported != nil {
+		in, out := &in.ImageSupported, &out.ImageSupported
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageStatus.
+func (in *VirtualMachineImageStatus) DeepCopy() *VirtualMachineImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImport) DeepCopyInto(out *VirtualMachineImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImport.
+func (in *VirtualMachineImport) DeepCopy() *VirtualMachineImport {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImportList) DeepCopyInto(out *VirtualMachineImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImportList.
+func (in *VirtualMachineImportList) DeepCopy() *VirtualMachineImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImportSource) DeepCopyInto(out *VirtualMachineImportSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImportSource.
+func (in *VirtualMachineImportSource) DeepCopy() *VirtualMachineImportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImportSpec) DeepCopyInto(out *VirtualMachineImportSpec) {
+	*out = *in
+	out.Source = in.Source
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImportSpec.
+func (in *VirtualMachineImportSpec) DeepCopy() *VirtualMachineImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImportStatus) DeepCopyInto(out *VirtualMachineImportStatus) {
+	*out = *in
+	if in.InferredSpec != nil {
+		in, out := &in.InferredSpec, &out.InferredSpec
+		*out = new(VirtualMachineSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnsupportedSettings != nil {
+		in, out := &in.UnsupportedSettings, &out.UnsupportedSettings
+		*out = make([]VirtualMachineImportUnsupportedSetting, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImportStatus.
+func (in *VirtualMachineImportStatus) DeepCopy() *VirtualMachineImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImportUnsupportedSetting) DeepCopyInto(out *VirtualMachineImportUnsupportedSetting) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImportUnsupportedSetting.
+func (in *VirtualMachineImportUnsupportedSetting) DeepCopy() *VirtualMachineImportUnsupportedSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImportUnsupportedSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineList.
+func (in *VirtualMachineList) DeepCopy() *VirtualMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMemoryMetrics) DeepCopyInto(out *VirtualMachineMemoryMetrics) {
+	*out = *in
+	out.Active = in.Active.DeepCopy()
+	out.Consumed = in.Consumed.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMemoryMetrics.
+func (in *VirtualMachineMemoryMetrics) DeepCopy() *VirtualMachineMemoryMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMemoryMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMetadata) DeepCopyInto(out *VirtualMachineMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMetadata.
+func (in *VirtualMachineMetadata) DeepCopy() *VirtualMachineMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMetrics) DeepCopyInto(out *VirtualMachineMetrics) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	out.Window = in.Window
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	in.Memory.DeepCopyInto(&out.Memory)
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineDiskMetrics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]VirtualMachineNetworkInterfaceMetrics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Uptime = in.Uptime
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMetrics.
+func (in *VirtualMachineMetrics) DeepCopy() *VirtualMachineMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineMetrics) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMetricsList) DeepCopyInto(out *VirtualMachineMetricsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineMetrics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMetricsList.
+func (in *VirtualMachineMetricsList) DeepCopy() *VirtualMachineMetricsList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMetricsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineMetricsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigration) DeepCopyInto(out *VirtualMachineMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigration.
+func (in *VirtualMachineMigration) DeepCopy() *VirtualMachineMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigrationList) DeepCopyInto(out *VirtualMachineMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigrationList.
+func (in *VirtualMachineMigrationList) DeepCopy() *VirtualMachineMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigrationSpec) DeepCopyInto(out *VirtualMachineMigrationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigrationSpec.
+func (in *VirtualMachineMigrationSpec) DeepCopy() *VirtualMachineMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigrationStatus) DeepCopyInto(out *VirtualMachineMigrationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigrationStatus.
+func (in *VirtualMachineMigrationStatus) DeepCopy() *VirtualMachineMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNUMASpec) DeepCopyInto(out *VirtualMachineNUMASpec) {
+	*out = *in
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNUMASpec.
+func (in *VirtualMachineNUMASpec) DeepCopy() *VirtualMachineNUMASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNUMASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterface) DeepCopyInto(out *VirtualMachineNetworkInterface) {
+	*out = *in
+	if in.ProviderRef != nil {
+		in, out := &in.ProviderRef, &out.ProviderRef
+		*out = new(NetworkInterfaceProviderReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterface.
+func (in *VirtualMachineNetworkInterface) DeepCopy() *VirtualMachineNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterfaceMetrics) DeepCopyInto(out *VirtualMachineNetworkInterfaceMetrics) {
+	*out = *in
+	out.ReceivedBytes = in.ReceivedBytes.DeepCopy()
+	out.TransmittedBytes = in.TransmittedBytes.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceMetrics.
+func (in *VirtualMachineNetworkInterfaceMetrics) DeepCopy() *VirtualMachineNetworkInterfaceMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterfaceMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePort) DeepCopyInto(out *VirtualMachinePort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePort.
+func (in *VirtualMachinePort) DeepCopy() *VirtualMachinePort {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequest) DeepCopyInto(out *VirtualMachinePublishRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequest.
+func (in *VirtualMachinePublishRequest) DeepCopy() *VirtualMachinePublishRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePublishRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestList) DeepCopyInto(out *VirtualMachinePublishRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachinePublishRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestList.
+func (in *VirtualMachinePublishRequestList) DeepCopy() *VirtualMachinePublishRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePublishRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestSource) DeepCopyInto(out *VirtualMachinePublishRequestSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestSource.
+func (in *VirtualMachinePublishRequestSource) DeepCopy() *VirtualMachinePublishRequestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestSpec) DeepCopyInto(out *VirtualMachinePublishRequestSpec) {
+	*out = *in
+	out.Source = in.Source
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestSpec.
+func (in *VirtualMachinePublishRequestSpec) DeepCopy() *VirtualMachinePublishRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestStatus) DeepCopyInto(out *VirtualMachinePublishRequestStatus) {
+	*out = *in
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(VirtualMachinePublishRequestSource)
+		**out = **in
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestStatus.
+func (in *VirtualMachinePublishRequestStatus) DeepCopy() *VirtualMachinePublishRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestTarget) DeepCopyInto(out *VirtualMachinePublishRequestTarget) {
+	*out = *in
+	out.LibraryItem = in.LibraryItem
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTarget.
+func (in *VirtualMachinePublishRequestTarget) DeepCopy() *VirtualMachinePublishRequestTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuota) DeepCopyInto(out *VirtualMachineQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImage.
-func (in *VirtualMachineImage) DeepCopy() *VirtualMachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuota.
+func (in *VirtualMachineQuota) DeepCopy() *VirtualMachineQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImage)
+	out := new(VirtualMachineQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaList) DeepCopyInto(out *VirtualMachineQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaList.
+func (in *VirtualMachineQuotaList) DeepCopy() *VirtualMachineQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaSpec) DeepCopyInto(out *VirtualMachineQuotaSpec) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaSpec.
+func (in *VirtualMachineQuotaSpec) DeepCopy() *VirtualMachineQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaStatus) DeepCopyInto(out *VirtualMachineQuotaStatus) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaStatus.
+func (in *VirtualMachineQuotaStatus) DeepCopy() *VirtualMachineQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSet) DeepCopyInto(out *VirtualMachineReplicaSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSet.
+func (in *VirtualMachineReplicaSet) DeepCopy() *VirtualMachineReplicaSet {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineReplicaSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSetList) DeepCopyInto(out *VirtualMachineReplicaSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineReplicaSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetList.
+func (in *VirtualMachineReplicaSetList) DeepCopy() *VirtualMachineReplicaSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineReplicaSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSetSpec) DeepCopyInto(out *VirtualMachineReplicaSetSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetSpec.
+func (in *VirtualMachineReplicaSetSpec) DeepCopy() *VirtualMachineReplicaSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineImage) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageList) DeepCopyInto(out *VirtualMachineImageList) {
+func (in *VirtualMachineReplicaSetStatus) DeepCopyInto(out *VirtualMachineReplicaSetStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineImage, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageList.
-func (in *VirtualMachineImageList) DeepCopy() *VirtualMachineImageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetStatus.
+func (in *VirtualMachineReplicaSetStatus) DeepCopy() *VirtualMachineReplicaSetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageList)
+	out := new(VirtualMachineReplicaSetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineImageList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageOSInfo) DeepCopyInto(out *VirtualMachineImageOSInfo) {
+func (in *VirtualMachineResizeStatus) DeepCopyInto(out *VirtualMachineResizeStatus) {
 	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageOSInfo.
-func (in *VirtualMachineImageOSInfo) DeepCopy() *VirtualMachineImageOSInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResizeStatus.
+func (in *VirtualMachineResizeStatus) DeepCopy() *VirtualMachineResizeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageOSInfo)
+	out := new(VirtualMachineResizeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageProductInfo) DeepCopyInto(out *VirtualMachineImageProductInfo) {
+func (in *VirtualMachineResourceRequirements) DeepCopyInto(out *VirtualMachineResourceRequirements) {
 	*out = *in
+	out.Memory = in.Memory.DeepCopy()
+	if in.VGPUProfileNames != nil {
+		in, out := &in.VGPUProfileNames, &out.VGPUProfileNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageProductInfo.
-func (in *VirtualMachineImageProductInfo) DeepCopy() *VirtualMachineImageProductInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResourceRequirements.
+func (in *VirtualMachineResourceRequirements) DeepCopy() *VirtualMachineResourceRequirements {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageProductInfo)
+	out := new(VirtualMachineResourceRequirements)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageSpec) DeepCopyInto(out *VirtualMachineImageSpec) {
+func (in *VirtualMachineResourceSpec) DeepCopyInto(out *VirtualMachineResourceSpec) {
 	*out = *in
-	out.ProviderRef = in.ProviderRef
-	out.ProductInfo = in.ProductInfo
-	out.OSInfo = in.OSInfo
-	if in.OVFEnv != nil {
-		in, out := &in.OVFEnv, &out.OVFEnv
-		*out = make(map[string]OvfProperty, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
+	out.Cpu = in.Cpu.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageSpec.
-func (in *VirtualMachineImageSpec) DeepCopy() *VirtualMachineImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResourceSpec.
+func (in *VirtualMachineResourceSpec) DeepCopy() *VirtualMachineResourceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageSpec)
+	out := new(VirtualMachineResourceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageStatus) DeepCopyInto(out *VirtualMachineImageStatus) {
+func (in *VirtualMachineRestartBackoff) DeepCopyInto(out *VirtualMachineRestartBackoff) {
 	*out = *in
-	if in.ImageSupported != nil {
-		in, out := &in.ImageSupported, &out.ImageSupported
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageStatus.
-func (in *VirtualMachineImageStatus) DeepCopy() *VirtualMachineImageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestartBackoff.
+func (in *VirtualMachineRestartBackoff) DeepCopy() *VirtualMachineRestartBackoff {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageStatus)
+	out := new(VirtualMachineRestartBackoff)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
+func (in *VirtualMachineRestore) DeepCopyInto(out *VirtualMachineRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachine, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineList.
-func (in *VirtualMachineList) DeepCopy() *VirtualMachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestore.
+func (in *VirtualMachineRestore) DeepCopy() *VirtualMachineRestore {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineList)
+	out := new(VirtualMachineRestore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineRestore) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1645,68 +3514,103 @@ func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineMetadata) DeepCopyInto(out *VirtualMachineMetadata) {
+func (in *VirtualMachineRestoreList) DeepCopyInto(out *VirtualMachineRestoreList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMetadata.
-func (in *VirtualMachineMetadata) DeepCopy() *VirtualMachineMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreList.
+func (in *VirtualMachineRestoreList) DeepCopy() *VirtualMachineRestoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineMetadata)
+	out := new(VirtualMachineRestoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkInterface) DeepCopyInto(out *VirtualMachineNetworkInterface) {
+func (in *VirtualMachineRestoreSpec) DeepCopyInto(out *VirtualMachineRestoreSpec) {
 	*out = *in
-	if in.ProviderRef != nil {
-		in, out := &in.ProviderRef, &out.ProviderRef
-		*out = new(NetworkInterfaceProviderReference)
-		**out = **in
+	if in.VolumeMappings != nil {
+		in, out := &in.VolumeMappings, &out.VolumeMappings
+		*out = make([]VirtualMachineRestoreVolumeMapping, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterface.
-func (in *VirtualMachineNetworkInterface) DeepCopy() *VirtualMachineNetworkInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreSpec.
+func (in *VirtualMachineRestoreSpec) DeepCopy() *VirtualMachineRestoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkInterface)
+	out := new(VirtualMachineRestoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePort) DeepCopyInto(out *VirtualMachinePort) {
+func (in *VirtualMachineRestoreStatus) DeepCopyInto(out *VirtualMachineRestoreStatus) {
 	*out = *in
+	if in.RestoredVolumes != nil {
+		in, out := &in.RestoredVolumes, &out.RestoredVolumes
+		*out = make([]VirtualMachineRestoreVolumeMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePort.
-func (in *VirtualMachinePort) DeepCopy() *VirtualMachinePort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreStatus.
+func (in *VirtualMachineRestoreStatus) DeepCopy() *VirtualMachineRestoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePort)
+	out := new(VirtualMachineRestoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineResourceSpec) DeepCopyInto(out *VirtualMachineResourceSpec) {
+func (in *VirtualMachineRestoreVolumeMapping) DeepCopyInto(out *VirtualMachineRestoreVolumeMapping) {
 	*out = *in
-	out.Cpu = in.Cpu.DeepCopy()
-	out.Memory = in.Memory.DeepCopy()
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResourceSpec.
-func (in *VirtualMachineResourceSpec) DeepCopy() *VirtualMachineResourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreVolumeMapping.
+func (in *VirtualMachineRestoreVolumeMapping) DeepCopy() *VirtualMachineRestoreVolumeMapping {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineResourceSpec)
+	out := new(VirtualMachineRestoreVolumeMapping)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1805,6 +3709,11 @@ func (in *VirtualMachineServiceSpec) DeepCopyInto(out *VirtualMachineServiceSpec
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(PauseSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceSpec.
@@ -1821,6 +3730,13 @@ func (in *VirtualMachineServiceSpec) DeepCopy() *VirtualMachineServiceSpec {
 func (in *VirtualMachineServiceStatus) DeepCopyInto(out *VirtualMachineServiceStatus) {
 	*out = *in
 	in.LoadBalancer.DeepCopyInto(&out.LoadBalancer)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceStatus.
@@ -1902,6 +3818,11 @@ func (in *VirtualMachineSetResourcePolicySpec) DeepCopyInto(out *VirtualMachineS
 		*out = make([]ClusterModuleSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(PauseSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicySpec.
@@ -1922,6 +3843,13 @@ func (in *VirtualMachineSetResourcePolicyStatus) DeepCopyInto(out *VirtualMachin
 		*out = make([]ClusterModuleStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicyStatus.
@@ -1934,9 +3862,29 @@ func (in *VirtualMachineSetResourcePolicyStatus) DeepCopy() *VirtualMachineSetRe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSharesSpec) DeepCopyInto(out *VirtualMachineSharesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSharesSpec.
+func (in *VirtualMachineSharesSpec) DeepCopy() *VirtualMachineSharesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSharesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(VirtualMachineResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]VirtualMachinePort, len(*in))
@@ -1966,11 +3914,46 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(Probe)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestartBackoff != nil {
+		in, out := &in.RestartBackoff, &out.RestartBackoff
+		*out = new(VirtualMachineRestartBackoff)
+		**out = **in
+	}
 	if in.AdvancedOptions != nil {
 		in, out := &in.AdvancedOptions, &out.AdvancedOptions
 		*out = new(VirtualMachineAdvancedOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(VirtualMachineAffinitySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(VirtualMachineTopologySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(PauseSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalDevices != nil {
+		in, out := &in.AdditionalDevices, &out.AdditionalDevices
+		*out = new(VirtualDevices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Crypto != nil {
+		in, out := &in.Crypto, &out.Crypto
+		*out = new(VirtualMachineCryptoSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSpec.
@@ -2010,6 +3993,34 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AffinityViolations != nil {
+		in, out := &in.AffinityViolations, &out.AffinityViolations
+		*out = make([]VirtualMachineAffinityViolation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]VirtualMachineDeviceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Crypto != nil {
+		in, out := &in.Crypto, &out.Crypto
+		*out = new(VirtualMachineCryptoStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resize != nil {
+		in, out := &in.Resize, &out.Resize
+		*out = new(VirtualMachineResizeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatus.
@@ -2022,6 +4033,70 @@ func (in *VirtualMachineStatus) DeepCopy() *VirtualMachineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateSpec.
+func (in *VirtualMachineTemplateSpec) DeepCopy() *VirtualMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTopologySpec) DeepCopyInto(out *VirtualMachineTopologySpec) {
+	*out = *in
+	if in.AllowedZones != nil {
+		in, out := &in.AllowedZones, &out.AllowedZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpreadConstraints != nil {
+		in, out := &in.SpreadConstraints, &out.SpreadConstraints
+		*out = make([]VirtualMachineTopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTopologySpec.
+func (in *VirtualMachineTopologySpec) DeepCopy() *VirtualMachineTopologySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTopologySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTopologySpreadConstraint) DeepCopyInto(out *VirtualMachineTopologySpreadConstraint) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTopologySpreadConstraint.
+func (in *VirtualMachineTopologySpreadConstraint) DeepCopy() *VirtualMachineTopologySpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTopologySpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineVolume) DeepCopyInto(out *VirtualMachineVolume) {
 	*out = *in
@@ -2092,7 +4167,7 @@ func (in *VsphereVolumeSource) DeepCopyInto(out *VsphereVolumeSource) {
 	*out = *in
 	if in.Capacity != nil {
 		in, out := &in.Capacity, &out.Capacity
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
@@ -2203,3 +4278,19 @@ func (in *WebConsoleRequestStatus) DeepCopy() *WebConsoleRequestStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedVirtualMachineAffinityTerm) DeepCopyInto(out *WeightedVirtualMachineAffinityTerm) {
+	*out = *in
+	in.VirtualMachineAffinityTerm.DeepCopyInto(&out.VirtualMachineAffinityTerm)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedVirtualMachineAffinityTerm.
+func (in *WeightedVirtualMachineAffinityTerm) DeepCopy() *WeightedVirtualMachineAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedVirtualMachineAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}